@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"embed"
 	"fmt"
+	"go-asteroids/internal/hitbox"
 	"image"
+	"image/color"
 	_ "image/png"
 	"io/fs"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
@@ -16,21 +19,26 @@ import (
 //go:embed *
 var assets embed.FS
 
+/* hulls holds each sprite's outline, traced from the decoded image since ebiten cannot read pixels back before the game runs */
+var hulls = map[*ebiten.Image][]image.Point{}
+
+/* hullReach is the furthest any outline in hulls reaches from its sprite's centre */
+var hullReach float64
+
 var TitleFont = mustLoadFontFace("fonts/title.ttf")
 var ScoreFont = mustLoadFontFace("fonts/score.ttf")
 var LevelFont = mustLoadFontFace("fonts/score.ttf")
 var ExhaustSprite = mustLoadImage("images/fire.png")
 var LaserSprite = mustLoadImage("images/laser.png")
 var MeteorSprites = mustLoadImages("images/meteors/*png")
-var MeteorSpritesSmall = mustLoadImages("images/meteors-small/*png")
+var MeteorSpritesMedium = mustLoadImages("images/meteors-medium/*png")
+var MeteorSpritesSmall = mustLoadHalvedImages("images/meteors-medium/*png")
 var PlayerSprite = mustLoadImage("images/player.png")
 var ExplosionSprite = mustLoadImage("images/explosion.png")
 var ExplosionSmallSprite = mustLoadImage("images/explosion-small.png")
 var Explosion = createExplosion()
 var ThrustSound = mustLoadOggVorbis("audio/thrust.ogg")
-var LaserOneSound = mustLoadOggVorbis("audio/fire.ogg")
-var LaserTwoSound = mustLoadOggVorbis("audio/fire.ogg")
-var LaserThreeSound = mustLoadOggVorbis("audio/fire.ogg")
+var LaserSound = mustLoadOggVorbis("audio/fire.ogg")
 var ExplosionSound = mustLoadOggVorbis("audio/explosion.ogg")
 var BeatOneSound = mustLoadOggVorbis("audio/beat1.ogg")
 var BeatTwoSound = mustLoadOggVorbis("audio/beat2.ogg")
@@ -59,6 +67,15 @@ func mustLoadOggVorbis(name string) *vorbis.Stream {
 }
 
 func mustLoadImage(name string) *ebiten.Image {
+	img := mustDecodeImage(name)
+
+	sprite := ebiten.NewImageFromImage(img)
+	addHull(sprite, img)
+
+	return sprite
+}
+
+func mustDecodeImage(name string) image.Image {
 	f, err := assets.Open(name)
 	if err != nil {
 		panic(err)
@@ -71,7 +88,30 @@ func mustLoadImage(name string) *ebiten.Image {
 		panic(err)
 	}
 
-	return ebiten.NewImageFromImage(img)
+	return img
+}
+
+/* addHull traces sprite's outline from its decoded img and widens hullReach to take it in */
+func addHull(sprite *ebiten.Image, img image.Image) {
+	hull := hitbox.Hull(img)
+	hulls[sprite] = hull
+
+	b := img.Bounds()
+	for _, p := range hull {
+		hullReach = max(hullReach, math.Hypot(float64(p.X)-float64(b.Dx())/2, float64(p.Y)-float64(b.Dy())/2))
+	}
+}
+
+// Hull is the convex outline of a loaded sprite's solid pixels, relative to
+// its top-left corner, or nil for an image this package did not load.
+func Hull(sprite *ebiten.Image) []image.Point {
+	return hulls[sprite]
+}
+
+// HullReach is the furthest any loaded sprite's outline reaches from the
+// sprite's centre, however the sprite turns.
+func HullReach() float64 {
+	return hullReach
 }
 
 func mustLoadImages(path string) []*ebiten.Image {
@@ -88,6 +128,42 @@ func mustLoadImages(path string) []*ebiten.Image {
 	return images
 }
 
+/* mustLoadHalvedImages shrinks each image to half size, for sprites that have no artwork of their own */
+func mustLoadHalvedImages(path string) []*ebiten.Image {
+	matches, err := fs.Glob(assets, path)
+	if err != nil {
+		panic(err)
+	}
+
+	images := make([]*ebiten.Image, len(matches))
+	for i, match := range matches {
+		half := halve(mustDecodeImage(match))
+		images[i] = ebiten.NewImageFromImage(half)
+		addHull(images[i], half)
+	}
+
+	return images
+}
+
+/* halve averages each 2x2 block of img into one pixel */
+func halve(img image.Image) image.Image {
+	b := img.Bounds()
+	half := image.NewNRGBA(image.Rect(0, 0, b.Dx()/2, b.Dy()/2))
+
+	for y := range half.Rect.Dy() {
+		for x := range half.Rect.Dx() {
+			var r, g, bl, a uint32
+			for _, p := range [4]image.Point{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+				pr, pg, pb, pa := img.At(b.Min.X+2*x+p.X, b.Min.Y+2*y+p.Y).RGBA()
+				r, g, bl, a = r+pr, g+pg, bl+pb, a+pa
+			}
+			half.Set(x, y, color.RGBA64{uint16(r / 4), uint16(g / 4), uint16(bl / 4), uint16(a / 4)})
+		}
+	}
+
+	return half
+}
+
 func mustLoadFontFace(name string) *text.GoTextFaceSource {
 	f, err := assets.ReadFile(name)
 	if err != nil {
@@ -0,0 +1,55 @@
+package engine
+
+import "time"
+
+// StepRate is how many fixed steps the simulation takes per simulated second,
+// whatever rate ebiten calls Update at.
+const StepRate = 60
+
+// Dt is the simulated time one step covers, in seconds; every entity's Update
+// advances by it.
+const Dt = 1.0 / StepRate
+
+// StepsToDuration is the simulated time n steps cover.
+func StepsToDuration(n int) time.Duration {
+	/* multiply before dividing so whole seconds of steps come out exact */
+	return time.Duration(n) * time.Second / StepRate
+}
+
+// Clock turns ebiten's ticks into fixed simulation steps. It counts in
+// fractions of 1/(StepRate*tps) of a second, so no rounding builds up however
+// the two rates divide.
+type Clock struct {
+	tps       int
+	remainder int
+}
+
+// Advance accounts for one ebiten tick at tps ticks per second and returns
+// how many steps the simulation should take for it: more than one when ebiten
+// runs slower than StepRate, and sometimes none when it runs faster.
+func (c *Clock) Advance(tps int) int {
+	if tps <= 0 {
+		return 1
+	}
+
+	if tps != c.tps {
+		c.tps = tps
+		c.remainder = 0
+	}
+
+	c.remainder += StepRate
+	steps := c.remainder / tps
+	c.remainder %= tps
+
+	return steps
+}
+
+// Alpha is how far the clock has run past the last step, from 0 to 1, for
+// drawing entities between their previous and current states.
+func (c *Clock) Alpha() float64 {
+	if c.tps <= 0 {
+		return 1
+	}
+
+	return float64(c.remainder) / float64(c.tps)
+}
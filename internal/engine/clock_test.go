@@ -0,0 +1,32 @@
+package engine
+
+import "testing"
+
+func TestClockStepsAtAnyRate(t *testing.T) {
+	for _, tps := range []int{30, 60, 90, 120, 144, 240} {
+		var c Clock
+
+		steps := 0
+		for range tps {
+			steps += c.Advance(tps)
+		}
+
+		/* a second of ticks is a second of steps, with nothing left over */
+		if steps != StepRate || c.Alpha() != 0 {
+			t.Errorf("%d tps: %d steps and alpha %v after a second; want %d and 0", tps, steps, c.Alpha(), StepRate)
+		}
+	}
+}
+
+func TestClockAlphaBetweenSteps(t *testing.T) {
+	var c Clock
+
+	if steps := c.Advance(4 * StepRate); steps != 0 || c.Alpha() != 0.25 {
+		t.Fatalf("first quarter step: %d steps, alpha %v", steps, c.Alpha())
+	}
+	c.Advance(4 * StepRate)
+	c.Advance(4 * StepRate)
+	if steps := c.Advance(4 * StepRate); steps != 1 || c.Alpha() != 0 {
+		t.Fatalf("fourth quarter step: %d steps, alpha %v", steps, c.Alpha())
+	}
+}
@@ -1,24 +1,90 @@
 package engine
 
 import (
+	"go-asteroids/assets"
+	"math"
+	"slices"
+
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/solarlune/resolv"
 )
 
-func CircleFor(img *ebiten.Image, pos Vector) *resolv.Circle {
-	return resolv.NewCircle(pos.X, pos.Y, float64(img.Bounds().Dx()/2))
+// HitboxFor builds a convex hitbox from the sprite's solid pixels. Its points
+// are relative to the sprite's centre so it turns with the sprite; place it
+// with PlaceHitbox.
+func HitboxFor(img *ebiten.Image) *resolv.ConvexPolygon {
+	b := img.Bounds()
+	half := resolv.Vector{X: float64(b.Dx()) / 2, Y: float64(b.Dy()) / 2}
+
+	hull := assets.Hull(img)
+	if hull == nil {
+		return resolv.NewRectangle(0, 0, float64(b.Dx()), float64(b.Dy()))
+	}
+
+	points := make([]resolv.Vector, len(hull))
+	for i, p := range hull {
+		points[i] = resolv.Vector{X: float64(p.X) - half.X, Y: float64(p.Y) - half.Y}
+	}
+
+	return resolv.NewConvexPolygonVec(resolv.Vector{}, points)
+}
+
+// HitCircle builds a round hitbox of radius, centred wherever it is placed.
+func HitCircle(radius float64) *resolv.Circle {
+	return resolv.NewCircle(0, 0, radius)
 }
 
-func RectangleFor(img *ebiten.Image, pos Vector) *resolv.ConvexPolygon {
+// PlaceHitbox moves obj over a sprite drawn by DrawSprite at pos with rotation.
+func PlaceHitbox(obj *resolv.ConvexPolygon, img *ebiten.Image, pos Vector, rotation float64) {
 	b := img.Bounds()
-	return resolv.NewRectangle(pos.X, pos.Y, float64(b.Dx()), float64(b.Dy()))
+	obj.SetPosition(pos.X+float64(b.Dx())/2, pos.Y+float64(b.Dy())/2)
+
+	/* resolv turns the other way to ebiten's GeoM */
+	if obj.Rotation() != -rotation {
+		obj.SetRotation(-rotation)
+	}
 }
 
-func CheckCollision(obj *resolv.Circle) bool {
-	return obj.IntersectionTest(resolv.IntersectionTestSettings{
-		TestAgainst: obj.SelectTouchingCells(1).FilterShapes(),
-		OnIntersect: func(set resolv.IntersectionSet) bool {
+/* Touching returns the shapes tagged tag that meet obj, on either side of any edge */
+func Touching(obj resolv.IShape, tag resolv.Tags) []resolv.IShape {
+	space := obj.Space()
+	if space == nil {
+		return nil
+	}
+
+	var hits []resolv.IShape
+
+	b := obj.Bounds()
+	/* across an edge the two can meet off the screen, so search as far as any outline hangs over */
+	hang := assets.HullReach()
+	reach := Vector{hang, hang}
+	offsets := WrapOffsets(Vector{b.Min.X, b.Min.Y}.Sub(reach), Vector{b.Max.X, b.Max.Y}.Add(reach))
+	seamMargin := int(math.Ceil(hang / float64(min(space.CellWidth(), space.CellHeight()))))
+
+	home := obj.Position()
+	for _, offset := range offsets {
+		margin := 0
+		if offset != (Vector{}) {
+			obj.SetPosition(home.X+offset.X, home.Y+offset.Y)
+			margin = seamMargin
+		}
+
+		obj.SelectTouchingCells(margin).FilterShapes().ByTags(tag).Not(obj).ForEach(func(other resolv.IShape) bool {
+			if !slices.Contains(hits, other) && obj.IsIntersecting(other) {
+				hits = append(hits, other)
+			}
 			return true
-		},
-	})
+		})
+	}
+
+	if len(offsets) > 1 {
+		obj.SetPosition(home.X, home.Y)
+	}
+
+	return hits
+}
+
+// CheckCollision reports whether obj overlaps anything hostile in its space.
+func CheckCollision(obj resolv.IShape) bool {
+	return len(Touching(obj, TagHostile)) > 0
 }
@@ -0,0 +1,37 @@
+package engine
+
+import (
+	"go-asteroids/assets"
+	"math"
+	"testing"
+)
+
+func TestHitboxFitsInsideSprite(t *testing.T) {
+	sprite := assets.PlayerSprite
+	obj := HitboxFor(sprite)
+	PlaceHitbox(obj, sprite, Vector{X: 100, Y: 200}, 0)
+
+	b := sprite.Bounds()
+	bounds := obj.Bounds()
+	if bounds.Min.X < 100 || bounds.Min.Y < 200 ||
+		bounds.Max.X > 100+float64(b.Dx()) || bounds.Max.Y > 200+float64(b.Dy()) {
+		t.Errorf("hitbox bounds %v spill outside the sprite at (100, 200) size %v", bounds, b.Size())
+	}
+}
+
+func TestHitboxTurnsAboutSpriteCentre(t *testing.T) {
+	sprite := assets.PlayerSprite
+	obj := HitboxFor(sprite)
+
+	PlaceHitbox(obj, sprite, Vector{}, 0)
+	before := obj.Bounds().Center()
+
+	PlaceHitbox(obj, sprite, Vector{}, math.Pi)
+	after := obj.Bounds().Center()
+
+	centre := Vector{X: float64(sprite.Bounds().Dx()) / 2, Y: float64(sprite.Bounds().Dy()) / 2}
+	/* a half turn mirrors the outline through the sprite's centre */
+	if math.Abs(before.X+after.X-2*centre.X) > 1 || math.Abs(before.Y+after.Y-2*centre.Y) > 1 {
+		t.Errorf("half turn moved hitbox centre from %v to %v; want mirrored about %v", before, after, centre)
+	}
+}
@@ -9,4 +9,11 @@ var (
 	TagMeteor = resolv.NewTag("meteor")
 	TagSmall  = resolv.NewTag("small")
 	TagLarge  = resolv.NewTag("large")
+	TagMedium = resolv.NewTag("medium")
+
+	TagAlienLaser = resolv.NewTag("alienLaser")
+	TagShield     = resolv.NewTag("shield")
+
+	/* TagHostile matches anything that can kill the player */
+	TagHostile = TagMeteor | TagAlien | TagAlienLaser
 )
@@ -2,32 +2,169 @@ package engine
 
 import (
 	"time"
-
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// Timer measures simulated time, advancing one fixed step per Update, so its
+// durations hold whatever rate the game runs at.
+//
+// A one-shot timer fires once, when its duration is reached or on its first
+// Update if it has none, and then stays ready until Reset. A repeating timer fires every time its duration passes
+// and starts counting towards the next firing straight away; it is never left
+// ready, so read Update's result or set a callback instead of IsReady.
 type Timer struct {
-	currentTicks int
-	targetTicks  int
+	/* elapsed time scaled by StepRate, so each step adds exactly one second and nothing is lost to rounding */
+	scaled    time.Duration
+	duration  time.Duration
+	repeating bool
+	paused    bool
+	fired     int
+	callback  func()
 }
 
 func NewTimer(d time.Duration) *Timer {
 	return &Timer{
-		currentTicks: 0,
-		targetTicks:  int(d.Milliseconds()) * ebiten.TPS() / 1000,
+		scaled:   0,
+		duration: d,
 	}
 }
 
-func (t *Timer) Update() {
-	if t.currentTicks < t.targetTicks {
-		t.currentTicks++
+// NewRepeatingTimer is a Timer that fires every d.
+func NewRepeatingTimer(d time.Duration) *Timer {
+	t := NewTimer(d)
+	t.repeating = true
+
+	return t
+}
+
+// Update advances the timer by one step, unless it is paused, and returns how
+// many times it fired.
+func (t *Timer) Update() int {
+	if t.paused || (!t.repeating && t.IsReady() && t.fired > 0) {
+		return 0
+	}
+
+	t.scaled += time.Second
+
+	if !t.repeating {
+		if t.IsReady() {
+			t.fire()
+			return 1
+		}
+		return 0
+	}
+
+	/* a zero duration would fire forever */
+	if t.duration <= 0 {
+		return 0
+	}
+
+	n := 0
+	for t.scaled >= t.duration*StepRate {
+		t.scaled -= t.duration * StepRate
+		t.fire()
+		n++
+	}
+
+	return n
+}
+
+func (t *Timer) fire() {
+	t.fired++
+	if t.callback != nil {
+		t.callback()
 	}
 }
 
 func (t *Timer) IsReady() bool {
-	return t.currentTicks >= t.targetTicks
+	return t.Elapsed() >= t.duration
 }
 
+// Reset starts the timer counting from zero again and clears its firing
+// count. A paused timer stays paused.
 func (t *Timer) Reset() {
-	t.currentTicks = 0
+	t.scaled = 0
+	t.fired = 0
+}
+
+// Elapsed is the simulated time counted since the last reset, or for a
+// repeating timer since it last fired.
+func (t *Timer) Elapsed() time.Duration {
+	return t.scaled / StepRate
+}
+
+// Remaining is the simulated time left until the timer next fires.
+func (t *Timer) Remaining() time.Duration {
+	return max(t.duration-t.Elapsed(), 0)
+}
+
+// Progress is how far the timer is towards firing, from 0 to 1.
+func (t *Timer) Progress() float64 {
+	if t.duration <= 0 {
+		return 1
+	}
+
+	return min(float64(t.Elapsed())/float64(t.duration), 1)
+}
+
+// Fired is how many times the timer has fired since it was created or reset.
+func (t *Timer) Fired() int {
+	return t.fired
+}
+
+// SetDuration changes how long the timer runs for, keeping the time already counted.
+func (t *Timer) SetDuration(d time.Duration) {
+	t.duration = d
+}
+
+// SetCallback has the timer call f each time it fires; nil removes it.
+func (t *Timer) SetCallback(f func()) {
+	t.callback = f
+}
+
+// Pause stops the timer counting until Resume.
+func (t *Timer) Pause() {
+	t.paused = true
+}
+
+func (t *Timer) Resume() {
+	t.paused = false
+}
+
+func (t *Timer) IsPaused() bool {
+	return t.paused
+}
+
+// TimerGroup updates, pauses and resumes a set of timers together.
+type TimerGroup struct {
+	timers []*Timer
+}
+
+// Add puts timers in the group and returns the first, so a timer can be
+// created and grouped in one expression.
+func (g *TimerGroup) Add(timers ...*Timer) *Timer {
+	g.timers = append(g.timers, timers...)
+
+	if len(timers) == 0 {
+		return nil
+	}
+	return timers[0]
+}
+
+// Update advances every timer in the group by one step.
+func (g *TimerGroup) Update() {
+	for _, t := range g.timers {
+		t.Update()
+	}
+}
+
+func (g *TimerGroup) Pause() {
+	for _, t := range g.timers {
+		t.Pause()
+	}
+}
+
+func (g *TimerGroup) Resume() {
+	for _, t := range g.timers {
+		t.Resume()
+	}
 }
@@ -26,3 +26,113 @@ func TestTimerReadyResetCycle(t *testing.T) {
 		t.Fatal("timer should not be ready immediately after reset")
 	}
 }
+
+func TestTimerCountsSimulatedTime(t *testing.T) {
+	timer := NewTimer(time.Second)
+
+	for range StepRate - 1 {
+		timer.Update()
+	}
+	if timer.IsReady() {
+		t.Fatalf("timer ready after %v", timer.Elapsed())
+	}
+
+	timer.Update()
+	if !timer.IsReady() || timer.Elapsed() != time.Second {
+		t.Fatalf("after a second of steps: ready %t, elapsed %v", timer.IsReady(), timer.Elapsed())
+	}
+}
+
+func TestTimerPauseHoldsTime(t *testing.T) {
+	timer := NewTimer(time.Second)
+	timer.Update()
+
+	timer.Pause()
+	for range 2 * StepRate {
+		timer.Update()
+	}
+	if timer.IsReady() || timer.Elapsed() != StepsToDuration(1) {
+		t.Fatalf("paused timer moved on to %v", timer.Elapsed())
+	}
+
+	timer.Resume()
+	timer.Update()
+	if timer.Elapsed() != StepsToDuration(2) {
+		t.Fatalf("resumed timer at %v; want %v", timer.Elapsed(), StepsToDuration(2))
+	}
+}
+
+func TestTimerProgressAndRemaining(t *testing.T) {
+	timer := NewTimer(time.Second)
+	for range StepRate / 4 {
+		timer.Update()
+	}
+
+	if got := timer.Progress(); got != 0.25 {
+		t.Errorf("Progress() = %v; want 0.25", got)
+	}
+	if got := timer.Remaining(); got != 750*time.Millisecond {
+		t.Errorf("Remaining() = %v; want 750ms", got)
+	}
+}
+
+func TestRepeatingTimerFires(t *testing.T) {
+	timer := NewRepeatingTimer(250 * time.Millisecond)
+
+	calls := 0
+	timer.SetCallback(func() { calls++ })
+
+	fires := 0
+	for range StepRate {
+		fires += timer.Update()
+	}
+
+	/* a quarter second repeats four times a second, and is never left ready */
+	if fires != 4 || timer.Fired() != 4 || calls != 4 {
+		t.Errorf("fired %d times, Fired() = %d, %d callbacks; want 4 of each", fires, timer.Fired(), calls)
+	}
+	if timer.IsReady() {
+		t.Error("repeating timer left ready after firing")
+	}
+}
+
+func TestOneShotTimerFiresOnce(t *testing.T) {
+	for _, d := range []time.Duration{100 * time.Millisecond, 0} {
+		timer := NewTimer(d)
+
+		calls := 0
+		timer.SetCallback(func() { calls++ })
+
+		first := timer.Update()
+		if d == 0 && first != 1 {
+			t.Error("zero length one-shot timer did not fire on its first Update")
+		}
+
+		fires := first
+		for range StepRate - 1 {
+			fires += timer.Update()
+		}
+		if fires != 1 || timer.Fired() != 1 || calls != 1 {
+			t.Errorf("%v one-shot timer fired %d times with %d callbacks; want 1", d, fires, calls)
+		}
+	}
+}
+
+func TestTimerGroupPausesTogether(t *testing.T) {
+	var group TimerGroup
+	a := group.Add(NewTimer(time.Second))
+	b := group.Add(NewRepeatingTimer(time.Second))
+
+	group.Pause()
+	group.Update()
+	a.Update()
+	if a.Elapsed() != 0 || b.Elapsed() != 0 {
+		t.Fatalf("paused group counted %v and %v", a.Elapsed(), b.Elapsed())
+	}
+
+	group.Resume()
+	group.Update()
+	if a.Elapsed() != StepsToDuration(1) || b.Elapsed() != StepsToDuration(1) {
+		t.Fatalf("resumed group counted %v and %v; want one step each", a.Elapsed(), b.Elapsed())
+	}
+}
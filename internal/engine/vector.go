@@ -7,24 +7,79 @@ type Vector struct {
 	Y float64
 }
 
-func (v Vector) Normalize() Vector {
-	magnitude := math.Sqrt(v.X*v.X + v.Y*v.Y)
-	return Vector{v.X / magnitude, v.Y / magnitude}
+// FromAngle is the unit vector pointing angle radians clockwise from the
+// positive X axis (Y grows down the screen).
+func FromAngle(angle float64) Vector {
+	sin, cos := math.Sincos(angle)
+	return Vector{cos, sin}
+}
+
+// Heading is the unit vector a sprite rotated by rotation faces. Sprites are
+// drawn pointing up, so a rotation of zero heads towards the top of the
+// screen.
+func Heading(rotation float64) Vector {
+	sin, cos := math.Sincos(rotation)
+	return Vector{sin, -cos}
+}
+
+func (v Vector) Add(o Vector) Vector {
+	return Vector{v.X + o.X, v.Y + o.Y}
+}
+
+func (v Vector) Sub(o Vector) Vector {
+	return Vector{v.X - o.X, v.Y - o.Y}
+}
+
+func (v Vector) Scale(factor float64) Vector {
+	return Vector{v.X * factor, v.Y * factor}
+}
+
+func (v Vector) Dot(o Vector) float64 {
+	return v.X*o.X + v.Y*o.Y
+}
+
+func (v Vector) Length() float64 {
+	return math.Hypot(v.X, v.Y)
+}
+
+// Distance is how far v is from o.
+func (v Vector) Distance(o Vector) float64 {
+	return v.Sub(o).Length()
+}
+
+// Angle is the direction of v in radians, as FromAngle takes it.
+func (v Vector) Angle() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// Rotate turns v by angle radians, clockwise on screen.
+func (v Vector) Rotate(angle float64) Vector {
+	sin, cos := math.Sincos(angle)
+	return Vector{v.X*cos - v.Y*sin, v.X*sin + v.Y*cos}
 }
 
-// WrapPosition wraps pos to the opposite edge when it leaves the screen.
-func WrapPosition(pos Vector) Vector {
-	if pos.X >= ScreenWidth {
-		pos.X = 0
-	} else if pos.X < 0 {
-		pos.X = ScreenWidth
+// Normalize is v scaled to length 1. The zero vector has no direction and
+// stays zero.
+func (v Vector) Normalize() Vector {
+	length := v.Length()
+	if length == 0 {
+		return Vector{}
 	}
 
-	if pos.Y >= ScreenHeight {
-		pos.Y = 0
-	} else if pos.Y < 0 {
-		pos.Y = ScreenHeight
+	return Vector{v.X / length, v.Y / length}
+}
+
+// Clamp shortens v to max long, keeping its direction. Shorter vectors are
+// returned unchanged.
+func (v Vector) Clamp(max float64) Vector {
+	if length := v.Length(); length > max {
+		return v.Scale(max / length)
 	}
 
-	return pos
+	return v
+}
+
+// Lerp is the point alpha of the way from v to to.
+func (v Vector) Lerp(to Vector, alpha float64) Vector {
+	return Vector{v.X + (to.X-v.X)*alpha, v.Y + (to.Y-v.Y)*alpha}
 }
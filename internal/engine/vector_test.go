@@ -17,3 +17,122 @@ func TestVectorNormalize(t *testing.T) {
 		t.Fatalf("normalized magnitude = %v, want 1", mag)
 	}
 }
+
+const epsilon = 1e-9
+
+func near(a, b Vector) bool {
+	return math.Abs(a.X-b.X) < epsilon && math.Abs(a.Y-b.Y) < epsilon
+}
+
+func TestNormalizeZero(t *testing.T) {
+	if got := (Vector{}).Normalize(); got != (Vector{}) {
+		t.Fatalf("Normalize() of zero = %+v, want zero", got)
+	}
+}
+
+func TestVectorArithmetic(t *testing.T) {
+	a := Vector{X: 3, Y: -4}
+	b := Vector{X: -1, Y: 2}
+
+	tests := []struct {
+		name string
+		got  Vector
+		want Vector
+	}{
+		{"Add", a.Add(b), Vector{2, -2}},
+		{"Sub", a.Sub(b), Vector{4, -6}},
+		{"Scale", a.Scale(-0.5), Vector{-1.5, 2}},
+		{"Scale zero", a.Scale(0), Vector{}},
+		{"Lerp start", a.Lerp(b, 0), a},
+		{"Lerp middle", a.Lerp(b, 0.5), Vector{1, -1}},
+		{"Lerp end", a.Lerp(b, 1), b},
+	}
+
+	for _, tt := range tests {
+		if !near(tt.got, tt.want) {
+			t.Errorf("%s = %+v; want %+v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestVectorMeasures(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"Dot", Vector{3, -4}.Dot(Vector{-1, 2}), -11},
+		{"Dot perpendicular", Vector{1, 0}.Dot(Vector{0, 5}), 0},
+		{"Length", Vector{3, -4}.Length(), 5},
+		{"Length zero", Vector{}.Length(), 0},
+		{"Distance", Vector{1, 1}.Distance(Vector{4, 5}), 5},
+		{"Distance to self", Vector{7, 7}.Distance(Vector{7, 7}), 0},
+		{"Angle right", Vector{2, 0}.Angle(), 0},
+		{"Angle down", Vector{0, 2}.Angle(), math.Pi / 2},
+		{"Angle up", Vector{0, -2}.Angle(), -math.Pi / 2},
+		{"Angle left", Vector{-2, 0}.Angle(), math.Pi},
+	}
+
+	for _, tt := range tests {
+		if math.Abs(tt.got-tt.want) > epsilon {
+			t.Errorf("%s = %v; want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestVectorDirections(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Vector
+		want Vector
+	}{
+		{"FromAngle 0", FromAngle(0), Vector{1, 0}},
+		{"FromAngle quarter", FromAngle(math.Pi / 2), Vector{0, 1}},
+		{"FromAngle half", FromAngle(math.Pi), Vector{-1, 0}},
+		{"Heading up", Heading(0), Vector{0, -1}},
+		{"Heading right", Heading(math.Pi / 2), Vector{1, 0}},
+		{"Heading down", Heading(math.Pi), Vector{0, 1}},
+		{"Rotate quarter", Vector{2, 0}.Rotate(math.Pi / 2), Vector{0, 2}},
+		{"Rotate back", Vector{0, 2}.Rotate(-math.Pi / 2), Vector{2, 0}},
+		{"Rotate full turn", Vector{3, 4}.Rotate(2 * math.Pi), Vector{3, 4}},
+		{"Rotate zero", Vector{}.Rotate(1), Vector{}},
+	}
+
+	for _, tt := range tests {
+		if !near(tt.got, tt.want) {
+			t.Errorf("%s = %+v; want %+v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestAngleRoundTrips(t *testing.T) {
+	for _, angle := range []float64{-3, -1, 0, 0.5, 2, 3} {
+		if got := FromAngle(angle).Angle(); math.Abs(got-angle) > epsilon {
+			t.Errorf("FromAngle(%v).Angle() = %v", angle, got)
+		}
+		if got := FromAngle(angle).Scale(7).Normalize(); !near(got, FromAngle(angle)) {
+			t.Errorf("FromAngle(%v) scaled and normalized = %+v", angle, got)
+		}
+	}
+}
+
+func TestVectorClamp(t *testing.T) {
+	tests := []struct {
+		v    Vector
+		max  float64
+		want Vector
+	}{
+		{Vector{3, 4}, 10, Vector{3, 4}},
+		{Vector{3, 4}, 5, Vector{3, 4}},
+		{Vector{3, 4}, 2.5, Vector{1.5, 2}},
+		{Vector{-6, 8}, 5, Vector{-3, 4}},
+		{Vector{}, 1, Vector{}},
+		{Vector{3, 4}, 0, Vector{}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.v.Clamp(tt.max); !near(got, tt.want) {
+			t.Errorf("%+v.Clamp(%v) = %+v; want %+v", tt.v, tt.max, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+/* the screen wraps like a torus, and sprites wrap by their centres */
+
+// Wrap folds pos back onto the screen.
+func Wrap(pos Vector) Vector {
+	return Vector{wrapAxis(pos.X, ScreenWidth), wrapAxis(pos.Y, ScreenHeight)}
+}
+
+// WrapSprite folds a sprite drawn at pos back onto the screen by its centre.
+func WrapSprite(pos Vector, img *ebiten.Image) Vector {
+	bounds := img.Bounds()
+	half := Vector{float64(bounds.Dx()) / 2, float64(bounds.Dy()) / 2}
+
+	return Wrap(pos.Add(half)).Sub(half)
+}
+
+func wrapAxis(v, size float64) float64 {
+	v = math.Mod(v, size)
+	if v < 0 {
+		v += size
+	}
+
+	/* a hair below zero rounds up to size itself */
+	if v >= size {
+		v -= size
+	}
+
+	return v
+}
+
+// WrapDelta is the shortest step from from to to, going across an edge when
+// that is nearer.
+func WrapDelta(from, to Vector) Vector {
+	d := to.Sub(from)
+	return Vector{nearestAxis(d.X, ScreenWidth), nearestAxis(d.Y, ScreenHeight)}
+}
+
+func nearestAxis(d, size float64) float64 {
+	d = math.Mod(d, size)
+	if d > size/2 {
+		d -= size
+	} else if d < -size/2 {
+		d += size
+	}
+
+	return d
+}
+
+// WrapDistance is how far apart a and b are the short way round.
+func WrapDistance(a, b Vector) float64 {
+	return WrapDelta(a, b).Length()
+}
+
+// WrapDirection is the unit vector from from towards to the short way round.
+func WrapDirection(from, to Vector) Vector {
+	return WrapDelta(from, to).Normalize()
+}
+
+// WrapOffsets are the shifts at which a box spanning min to max shows on the
+// screen: no shift, plus one for each edge it hangs over.
+func WrapOffsets(min, max Vector) []Vector {
+	xs := axisOffsets(min.X, max.X, ScreenWidth)
+	ys := axisOffsets(min.Y, max.Y, ScreenHeight)
+
+	offsets := make([]Vector, 0, len(xs)*len(ys))
+	for _, y := range ys {
+		for _, x := range xs {
+			offsets = append(offsets, Vector{x, y})
+		}
+	}
+
+	return offsets
+}
+
+func axisOffsets(min, max, size float64) []float64 {
+	offsets := []float64{0}
+	if min < 0 {
+		offsets = append(offsets, size)
+	}
+	if max > size {
+		offsets = append(offsets, -size)
+	}
+
+	return offsets
+}
+
+// DrawWrapped draws img as DrawSprite does, again on the far side of any
+// edge it hangs over.
+func DrawWrapped(screen, img *ebiten.Image, pos Vector, rotation float64) {
+	bounds := img.Bounds()
+	half := Vector{float64(bounds.Dx()) / 2, float64(bounds.Dy()) / 2}
+
+	/* a turning sprite can reach as far as its corners in any direction */
+	centre := pos.Add(half)
+	reach := Vector{half.Length(), half.Length()}
+
+	for _, offset := range WrapOffsets(centre.Sub(reach), centre.Add(reach)) {
+		DrawSprite(screen, img, pos.Add(offset), rotation)
+	}
+}
+
+// Interpolate is where to draw something that moved from from to to in the
+// last step, alpha of the way through the next. A step across an edge is
+// drawn crossing it rather than streaking back over the screen.
+func Interpolate(from, to Vector, alpha float64) Vector {
+	return from.Add(WrapDelta(from, to).Scale(alpha))
+}
@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		pos  Vector
+		want Vector
+	}{
+		{Vector{100, 200}, Vector{100, 200}},
+		{Vector{ScreenWidth, ScreenHeight}, Vector{0, 0}},
+		{Vector{ScreenWidth + 5, -5}, Vector{5, ScreenHeight - 5}},
+		{Vector{-1, ScreenHeight + 1}, Vector{ScreenWidth - 1, 1}},
+		{Vector{-2*ScreenWidth - 3, 3 * ScreenHeight}, Vector{ScreenWidth - 3, 0}},
+		{Vector{-1e-18, 0}, Vector{0, 0}},
+	}
+
+	for _, tt := range tests {
+		if got := Wrap(tt.pos); !near(got, tt.want) {
+			t.Errorf("Wrap(%+v) = %+v; want %+v", tt.pos, got, tt.want)
+		}
+	}
+}
+
+func TestWrapDelta(t *testing.T) {
+	tests := []struct {
+		from, to Vector
+		want     Vector
+	}{
+		{Vector{10, 10}, Vector{30, 50}, Vector{20, 40}},
+		{Vector{ScreenWidth - 10, 100}, Vector{10, 100}, Vector{20, 0}},
+		{Vector{10, 100}, Vector{ScreenWidth - 10, 100}, Vector{-20, 0}},
+		{Vector{100, 5}, Vector{100, ScreenHeight - 5}, Vector{0, -10}},
+		{Vector{5, 5}, Vector{ScreenWidth - 5, ScreenHeight - 5}, Vector{-10, -10}},
+	}
+
+	for _, tt := range tests {
+		if got := WrapDelta(tt.from, tt.to); !near(got, tt.want) {
+			t.Errorf("WrapDelta(%+v, %+v) = %+v; want %+v", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	a, b := Vector{ScreenWidth - 3, 0}, Vector{1, 3}
+	if got := WrapDistance(a, b); got != 5 {
+		t.Errorf("WrapDistance(%+v, %+v) = %v; want 5", a, b, got)
+	}
+	if got := WrapDirection(a, b); !near(got, Vector{0.8, 0.6}) {
+		t.Errorf("WrapDirection(%+v, %+v) = %+v; want {0.8 0.6}", a, b, got)
+	}
+}
+
+func TestWrapSprite(t *testing.T) {
+	img := ebiten.NewImage(40, 20)
+
+	tests := []struct {
+		pos  Vector
+		want Vector
+	}{
+		/* the centre is still on the screen, however far the sprite hangs over */
+		{Vector{ScreenWidth - 21, -9}, Vector{ScreenWidth - 21, -9}},
+		{Vector{ScreenWidth - 20, 100}, Vector{-20, 100}},
+		{Vector{-21, ScreenHeight - 10}, Vector{ScreenWidth - 21, -10}},
+	}
+
+	for _, tt := range tests {
+		if got := WrapSprite(tt.pos, img); !near(got, tt.want) {
+			t.Errorf("WrapSprite(%+v) = %+v; want %+v", tt.pos, got, tt.want)
+		}
+	}
+}
+
+func TestWrapOffsets(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max Vector
+		want     []Vector
+	}{
+		{"inside", Vector{10, 10}, Vector{50, 50}, []Vector{{0, 0}}},
+		{"over the right", Vector{ScreenWidth - 10, 10}, Vector{ScreenWidth + 10, 50}, []Vector{{0, 0}, {-ScreenWidth, 0}}},
+		{"over the top", Vector{10, -10}, Vector{50, 10}, []Vector{{0, 0}, {0, ScreenHeight}}},
+		{"over a corner", Vector{-10, ScreenHeight - 10}, Vector{10, ScreenHeight + 10},
+			[]Vector{{0, 0}, {ScreenWidth, 0}, {0, -ScreenHeight}, {ScreenWidth, -ScreenHeight}}},
+	}
+
+	for _, tt := range tests {
+		if got := WrapOffsets(tt.min, tt.max); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: WrapOffsets = %v; want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestInterpolateAcrossSeam(t *testing.T) {
+	from := Vector{X: ScreenWidth - 2, Y: 100}
+	to := Vector{X: 2, Y: 100}
+
+	/* halfway through a step across the edge is on the edge, not mid-screen */
+	if got := Interpolate(from, to, 0.5); !near(got, Vector{X: ScreenWidth, Y: 100}) {
+		t.Errorf("Interpolate across the seam = %+v; want {%v 100}", got, ScreenWidth)
+	}
+	if got := Interpolate(Vector{X: 0, Y: 0}, Vector{X: 10, Y: 20}, 0.5); got != (Vector{X: 5, Y: 10}) {
+		t.Errorf("Interpolate halfway = %+v; want {5 10}", got)
+	}
+}
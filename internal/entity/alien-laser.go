@@ -3,6 +3,7 @@ package entity
 import (
 	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
+	"image/color"
 	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -11,13 +12,21 @@ import (
 
 const (
 	alienLaserSpeedPerSecond = 1000.0
+
+	/* how far past the edge an alien laser is culled */
+	alienLaserCullMargin = 200.0
 )
 
+/* deflectedTint marks a laser turned away by the shield */
+var deflectedTint = color.RGBA{R: 0x80, G: 0xe0, B: 0xff, A: 0xff}
+
 type AlienLaser struct {
-	Position engine.Vector
-	rotation float64
-	sprite   *ebiten.Image
-	LaserObj *resolv.ConvexPolygon
+	Position  engine.Vector
+	previous  engine.Vector
+	rotation  float64
+	sprite    *ebiten.Image
+	LaserObj  *resolv.ConvexPolygon
+	Deflected bool
 }
 
 func NewAlienLaser(pos engine.Vector, rotation float64) *AlienLaser {
@@ -30,29 +39,33 @@ func NewAlienLaser(pos engine.Vector, rotation float64) *AlienLaser {
 	/* create an alien laser obj */
 	al := &AlienLaser{
 		Position: pos,
+		previous: pos,
 		rotation: rotation,
 		sprite:   sprite,
-		LaserObj: engine.RectangleFor(sprite, pos),
+		LaserObj: engine.HitboxFor(sprite),
 	}
 
 	/* set the position of the collision obj */
-	al.LaserObj.SetPosition(pos.X, pos.Y)
-	al.LaserObj.Tags().Set(engine.TagLaser)
+	al.placeHitbox()
+	al.LaserObj.Tags().Set(engine.TagAlienLaser)
 
 	return al
 
 }
 
-func (al *AlienLaser) Update() {
-	speed := alienLaserSpeedPerSecond / float64(ebiten.TPS())
+func (al *AlienLaser) Update(dt float64) {
+	al.previous = al.Position
+	al.Position = al.Position.Add(engine.Heading(al.rotation).Scale(alienLaserSpeedPerSecond * dt))
 
-	al.Position.X += math.Sin(al.rotation) * speed
-	al.Position.Y += math.Cos(al.rotation) * -speed
+	al.placeHitbox()
+}
 
-	al.LaserObj.SetPosition(al.Position.X, al.Position.Y)
+/* alien lasers are drawn centred on Position, unlike the sprites DrawSprite places */
+func (al *AlienLaser) placeHitbox() {
+	engine.PlaceHitbox(al.LaserObj, al.sprite, engine.CenterSprite(al.Position, al.sprite), al.rotation)
 }
 
-func (al *AlienLaser) Draw(screen *ebiten.Image) {
+func (al *AlienLaser) Draw(screen *ebiten.Image, alpha float64) {
 	bounds := al.sprite.Bounds()
 	halfW := float64(bounds.Dx()) / 2
 	halfH := float64(bounds.Dy()) / 2
@@ -60,8 +73,31 @@ func (al *AlienLaser) Draw(screen *ebiten.Image) {
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(-halfW, -halfH)
 	op.GeoM.Rotate(al.rotation)
-	op.GeoM.Translate(al.Position.X, al.Position.Y)
+	pos := engine.Interpolate(al.previous, al.Position, alpha)
+	op.GeoM.Translate(pos.X, pos.Y)
+
+	if al.Deflected {
+		op.ColorScale.ScaleWithColor(deflectedTint)
+	}
 
 	screen.DrawImage(al.sprite, op)
 
 }
+
+// Deflect sends the laser straight away from from, after which it can no longer hurt the player.
+func (al *AlienLaser) Deflect(from engine.Vector) {
+	al.rotation = al.Position.Sub(from).Angle() + math.Pi/2
+	al.Deflected = true
+}
+
+func (al *AlienLaser) Dead() bool {
+	return offscreen(al.Position, alienLaserCullMargin)
+}
+
+func (al *AlienLaser) Collider() resolv.IShape {
+	return al.LaserObj
+}
+
+func (al *AlienLaser) Layer() Layer {
+	return LayerAlienLasers
+}
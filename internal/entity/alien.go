@@ -10,17 +10,33 @@ import (
 	"github.com/solarlune/resolv"
 )
 
+/* smallAlienScale shrinks the aimed saucers, which are harder to hit and worth more */
+const smallAlienScale = 0.6
+
+/* how far past the edge an alien that has flown across is removed */
+const alienCullMargin = 200.0
+
+/* the random part of an alien's speed, in pixels per second */
+const (
+	edgeSpeedSpread        = 150.0
+	intelligentSpeedSpread = 90.0
+)
+
 type Alien struct {
 	Sprite        *ebiten.Image
-	Obj           *resolv.Circle
+	Obj           *resolv.ConvexPolygon
 	Position      engine.Vector
+	previous      engine.Vector
 	angle         float64
 	movement      engine.Vector
 	IsIntelligent bool
+	// Small aliens are the aimed ones, drawn and hit at a reduced size.
+	Small     bool
+	explosion *engine.Timer
 }
 
-func NewAlien(baseVelocity float64, playerPos engine.Vector) *Alien {
-	sprite := assets.AlienSprites[rand.Intn(len(assets.AlienSprites))]
+func NewAlien(rng *rand.Rand, baseVelocity float64, playerPos engine.Vector) *Alien {
+	sprite := assets.AlienSprites[rng.Intn(len(assets.AlienSprites))]
 
 	var pos, movement engine.Vector
 	var angle float64
@@ -29,56 +45,72 @@ func NewAlien(baseVelocity float64, playerPos engine.Vector) *Alien {
 	fromRight := float64(engine.ScreenWidth + 100)
 	fromLeft := float64(-100)
 
-	switch rand.Intn(3) {
+	switch rng.Intn(3) {
 	case 0:
-		pos, movement = edgeSpawn(fromRight, baseVelocity, -1)
+		pos, movement = edgeSpawn(rng, fromRight, baseVelocity, -1)
 	case 1:
-		pos, movement = edgeSpawn(fromLeft, baseVelocity, +1)
+		pos, movement = edgeSpawn(rng, fromLeft, baseVelocity, +1)
 	case 2:
-		pos, angle, movement = intelligentSpawn(baseVelocity, playerPos)
+		pos, angle, movement = intelligentSpawn(rng, baseVelocity, playerPos)
 		intelligent = true
 	}
 
 	alien := Alien{
 		Sprite:        sprite,
 		Position:      pos,
-		Obj:           engine.CircleFor(sprite, pos),
+		previous:      pos,
+		Obj:           engine.HitboxFor(sprite),
 		angle:         angle,
 		movement:      movement,
 		IsIntelligent: intelligent,
+		Small:         intelligent,
 	}
 
-	alien.Obj.SetPosition(pos.X, pos.Y)
+	if alien.Small {
+		alien.Obj.SetScale(smallAlienScale, smallAlienScale)
+	}
+	alien.placeHitbox()
 	alien.Obj.Tags().Set(engine.TagAlien)
 
 	return &alien
 }
 
-func (a *Alien) Draw(screen *ebiten.Image) {
+func (a *Alien) Draw(screen *ebiten.Image, alpha float64) {
 	bounds := a.Sprite.Bounds()
 	halfW := float64(bounds.Dx()) / 2
 	halfH := float64(bounds.Dy()) / 2
 
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(-halfW, -halfH)
-	op.GeoM.Translate(a.Position.X, a.Position.Y)
+	if a.Small {
+		op.GeoM.Scale(smallAlienScale, smallAlienScale)
+	}
+	pos := engine.Interpolate(a.previous, a.Position, alpha)
+	op.GeoM.Translate(pos.X, pos.Y)
 	screen.DrawImage(a.Sprite, op)
 }
 
-func (a *Alien) Update() {
-	dx := a.movement.X
-	dy := a.movement.Y
+func (a *Alien) Update(dt float64) {
+	a.previous = a.Position
 
-	a.Position.X += dx
-	a.Position.Y += dy
+	a.Position = a.Position.Add(a.movement.Scale(dt))
 
-	a.Obj.SetPosition(a.Position.X, a.Position.Y)
+	if a.explosion != nil {
+		a.explosion.Update()
+	}
+
+	a.placeHitbox()
+}
+
+/* aliens are drawn centred on Position, unlike the sprites DrawSprite places */
+func (a *Alien) placeHitbox() {
+	engine.PlaceHitbox(a.Obj, a.Sprite, engine.CenterSprite(a.Position, a.Sprite), 0)
 }
 
-func edgeSpawn(x, baseVelocity, dir float64) (pos, movement engine.Vector) {
-	y := float64(rand.Intn(engine.ScreenHeight-100) + 100)
+func edgeSpawn(rng *rand.Rand, x, baseVelocity, dir float64) (pos, movement engine.Vector) {
+	y := float64(rng.Intn(engine.ScreenHeight-100) + 100)
 
-	velocity := baseVelocity + rand.Float64()*2.5
+	velocity := baseVelocity + rng.Float64()*edgeSpeedSpread
 	pos = engine.Vector{X: x, Y: y}
 	movement = engine.Vector{X: dir * velocity}
 
@@ -86,22 +118,42 @@ func edgeSpawn(x, baseVelocity, dir float64) (pos, movement engine.Vector) {
 }
 
 // spawns an alien on a circle around screen center and aims its movement toward the player.
-func intelligentSpawn(baseVelocity float64, playerPos engine.Vector) (pos engine.Vector, angle float64, movement engine.Vector) {
+func intelligentSpawn(rng *rand.Rand, baseVelocity float64, playerPos engine.Vector) (pos engine.Vector, angle float64, movement engine.Vector) {
 	middle := engine.Vector{X: engine.ScreenWidth / 2, Y: engine.ScreenHeight / 2}
-	angle = rand.Float64() * 2 * math.Pi
+	angle = rng.Float64() * 2 * math.Pi
 	r := engine.ScreenHeight / 2.0
 
-	pos = engine.Vector{
-		X: middle.X + math.Cos(angle)*r,
-		Y: middle.Y + math.Sin(angle)*r,
-	}
-
-	velocity := baseVelocity + rand.Float64()*1.5
-	direction := engine.Vector{
-		X: playerPos.X - pos.X,
-		Y: playerPos.Y / -pos.Y,
-	}.Normalize()
+	pos = middle.Add(engine.FromAngle(angle).Scale(r))
 
-	movement = engine.Vector{X: direction.X * velocity, Y: direction.Y * velocity}
+	velocity := baseVelocity + rng.Float64()*intelligentSpeedSpread
+	movement = playerPos.Sub(pos).Normalize().Scale(velocity)
 	return pos, angle, movement
 }
+
+// Explode shows the alien blowing up; it stops colliding with anything at
+// once and is removed once the explosion has played.
+func (a *Alien) Explode() {
+	a.Sprite = assets.ExplosionSprite
+	a.explosion = engine.NewTimer(explosionTime)
+
+	/* the wreck stays in the space while it plays out, but nothing finds it there any more */
+	a.Obj.Tags().Unset(engine.TagAlien)
+}
+
+func (a *Alien) IsExploding() bool {
+	return a.explosion != nil
+}
+
+// Dead reports whether the alien has finished exploding or flown well clear
+// of the screen.
+func (a *Alien) Dead() bool {
+	return (a.explosion != nil && a.explosion.IsReady()) || offscreen(a.Position, alienCullMargin)
+}
+
+func (a *Alien) Collider() resolv.IShape {
+	return a.Obj
+}
+
+func (a *Alien) Layer() Layer {
+	return LayerAliens
+}
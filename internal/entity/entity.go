@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"go-asteroids/internal/engine"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+)
+
+/* explosionTime is how long a destroyed meteor or alien shows its explosion before it is removed */
+const explosionTime = 200 * time.Millisecond
+
+// Layer orders drawing: entities on lower layers are drawn first, underneath
+// the rest.
+type Layer int
+
+const (
+	LayerMeteors Layer = iota
+	LayerLasers
+	LayerAliens
+	LayerAlienLasers
+)
+
+// Entity is anything kept in a Registry: it moves on each step, draws itself
+// between steps, and collides through a shape in the registry's space.
+type Entity interface {
+	Update(dt float64)
+	Draw(screen *ebiten.Image, alpha float64)
+	Collider() resolv.IShape
+	// Dead reports whether the entity is finished with, so the registry can
+	// remove it.
+	Dead() bool
+	Layer() Layer
+}
+
+var (
+	_ Entity = (*Meteor)(nil)
+	_ Entity = (*Laser)(nil)
+	_ Entity = (*Alien)(nil)
+	_ Entity = (*AlienLaser)(nil)
+)
+
+/* offscreen reports whether pos is more than margin past any edge of the screen */
+func offscreen(pos engine.Vector, margin float64) bool {
+	return pos.X > engine.ScreenWidth+margin ||
+		pos.Y > engine.ScreenHeight+margin ||
+		pos.X < -margin ||
+		pos.Y < -margin
+}
@@ -3,13 +3,13 @@ package entity
 import (
 	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
-	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 type Exhaust struct {
 	position engine.Vector
+	previous engine.Vector
 	rotation float64
 	sprite   *ebiten.Image
 }
@@ -24,17 +24,18 @@ func NewExhaust(pos engine.Vector, rotation float64) *Exhaust {
 	/* create a exhaust obj */
 	return &Exhaust{
 		position: pos,
+		previous: pos,
 		rotation: rotation,
 		sprite:   sprite,
 	}
 }
 
-func (e *Exhaust) Update() {
-	speed := engine.MaxAcceleration / float64(ebiten.TPS())
-	e.position.X += math.Sin(e.rotation) * speed
-	e.position.Y += math.Cos(e.rotation) * -speed
+func (e *Exhaust) Update(dt float64) {
+	e.previous = e.position
+
+	e.position = e.position.Add(engine.Heading(e.rotation).Scale(engine.MaxAcceleration * dt))
 }
 
-func (e *Exhaust) Draw(screen *ebiten.Image) {
-	engine.DrawSprite(screen, e.sprite, e.position, e.rotation)
+func (e *Exhaust) Draw(screen *ebiten.Image, alpha float64) {
+	engine.DrawWrapped(screen, e.sprite, engine.Interpolate(e.previous, e.position, alpha), e.rotation)
 }
@@ -3,7 +3,6 @@ package entity
 import (
 	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
-	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/solarlune/resolv"
@@ -11,16 +10,28 @@ import (
 
 const (
 	laserSpeedPerSecond = 1000.0
+
+	/* how far a wrapping laser flies before it fizzles out */
+	laserRange = 900.0
+
+	/* how far past the edge a laser that does not wrap is culled */
+	laserCullMargin = 200.0
 )
 
 type Laser struct {
 	Position engine.Vector
+	previous engine.Vector
 	rotation float64
 	sprite   *ebiten.Image
 	Obj      *resolv.ConvexPolygon
+
+	// Wraps sends the laser across the edges like everything else, for
+	// laserRange, instead of off the screen.
+	Wraps     bool
+	travelled float64
 }
 
-func NewLaser(pos engine.Vector, rotation float64, index int) *Laser {
+func NewLaser(pos engine.Vector, rotation float64) *Laser {
 	/* set the sprite */
 	sprite := assets.LaserSprite
 
@@ -30,32 +41,62 @@ func NewLaser(pos engine.Vector, rotation float64, index int) *Laser {
 	/* create a laser obj */
 	l := &Laser{
 		Position: pos,
+		previous: pos,
 		rotation: rotation,
 		sprite:   sprite,
-		Obj:      engine.RectangleFor(sprite, pos),
+		Obj:      engine.HitboxFor(sprite),
 	}
 
 	/* set the position of the collision obj */
-	l.Obj.SetPosition(pos.X, pos.Y)
-	l.Obj.SetData(&engine.ObjectData{Index: index})
+	engine.PlaceHitbox(l.Obj, sprite, pos, rotation)
 	l.Obj.Tags().Set(engine.TagLaser)
 
 	return l
 
 }
 
-func (l *Laser) Update() {
-	speed := laserSpeedPerSecond / float64(ebiten.TPS())
+func (l *Laser) Update(dt float64) {
+	l.previous = l.Position
+	l.Position = l.Position.Add(l.Direction().Scale(laserSpeedPerSecond * dt))
+	l.travelled += laserSpeedPerSecond * dt
+
+	if l.Wraps {
+		l.Position = engine.WrapSprite(l.Position, l.sprite)
+	}
+
+	engine.PlaceHitbox(l.Obj, l.sprite, l.Position, l.rotation)
+}
+
+// Direction is the unit vector the laser travels along.
+func (l *Laser) Direction() engine.Vector {
+	return engine.Heading(l.rotation)
+}
+
+// Dead reports whether the laser is finished with: a wrapping one once it
+// has flown its range, any other once it is well clear of the screen.
+func (l *Laser) Dead() bool {
+	if l.Wraps {
+		return l.travelled >= laserRange
+	}
 
-	dx := math.Sin(l.rotation) * speed
-	dy := math.Cos(l.rotation) * -speed
+	return offscreen(l.Position, laserCullMargin)
+}
 
-	l.Position.X += dx
-	l.Position.Y += dy
+func (l *Laser) Collider() resolv.IShape {
+	return l.Obj
+}
 
-	l.Obj.SetPosition(l.Position.X, l.Position.Y)
+func (l *Laser) Layer() Layer {
+	return LayerLasers
 }
 
-func (l *Laser) Draw(screen *ebiten.Image) {
-	engine.DrawSprite(screen, l.sprite, l.Position, l.rotation)
+func (l *Laser) Draw(screen *ebiten.Image, alpha float64) {
+	pos := engine.Interpolate(l.previous, l.Position, alpha)
+
+	if l.Wraps {
+		engine.DrawWrapped(screen, l.sprite, pos, l.rotation)
+		return
+	}
+
+	engine.DrawSprite(screen, l.sprite, pos, l.rotation)
 }
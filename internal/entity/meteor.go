@@ -10,30 +10,69 @@ import (
 	"github.com/solarlune/resolv"
 )
 
+/* speeds are per second of simulated time */
 const (
-	rotationSpeedMin = -0.02
-	rotationSpeedMax = 0.02
+	rotationSpeedMin  = -1.2
+	rotationSpeedMax  = 1.2
+	meteorSpeedSpread = 90.0
 )
 
+/* how a meteor breaks apart when shot */
+const (
+	splitChildren = 2
+	splitSpread   = math.Pi / 6
+	splitImpulse  = 45.0
+	splitSpeedUp  = 1.2
+)
+
+// MeteorSize is a meteor's tier; each split drops one size, and small meteors
+// are destroyed outright.
+type MeteorSize int
+
+const (
+	MeteorLarge MeteorSize = iota
+	MeteorMedium
+	MeteorSmall
+)
+
+func (s MeteorSize) sprites() []*ebiten.Image {
+	switch s {
+	case MeteorLarge:
+		return assets.MeteorSprites
+	case MeteorMedium:
+		return assets.MeteorSpritesMedium
+	default:
+		return assets.MeteorSpritesSmall
+	}
+}
+
+func (s MeteorSize) tag() resolv.Tags {
+	switch s {
+	case MeteorLarge:
+		return engine.TagLarge
+	case MeteorMedium:
+		return engine.TagMedium
+	default:
+		return engine.TagSmall
+	}
+}
+
 type Meteor struct {
 	Position      engine.Vector
 	rotation      float64
+	previous      engine.Vector
+	previousSpin  float64
 	Movement      engine.Vector
 	angle         float64
 	rotationSpeed float64
+	Size          MeteorSize
 	Sprite        *ebiten.Image
-	Obj           *resolv.Circle
-}
-
-func NewMeteor(baseVelocity float64, index int) *Meteor {
-	return newMeteor(baseVelocity, index, assets.MeteorSprites, engine.TagLarge)
-}
-
-func NewSmallMeteor(baseVelocity float64, index int) *Meteor {
-	return newMeteor(baseVelocity, index, assets.MeteorSpritesSmall, engine.TagSmall)
+	Obj           *resolv.ConvexPolygon
+	hullSprite    *ebiten.Image
+	explosion     *engine.Timer
 }
 
-func newMeteor(baseVelocity float64, index int, sprites []*ebiten.Image, sizeTag resolv.Tags) *Meteor {
+func NewMeteor(rng *rand.Rand, baseVelocity float64) *Meteor {
 	/* target the center of the screen */
 	target := engine.Vector{
 		X: engine.ScreenWidth / 2,
@@ -41,76 +80,161 @@ func newMeteor(baseVelocity float64, index int, sprites []*ebiten.Image, sizeTag
 	}
 
 	/* pick a random angle */
-	angle := rand.Float64() * 2 * math.Pi
+	angle := rng.Float64() * 2 * math.Pi
 
-	/* spawn distance from center */
-	r := engine.ScreenWidth/2.0 + 500
+	/* spawn where that direction meets the edge of the screen */
+	heading := engine.FromAngle(angle)
+	r := min(engine.ScreenWidth/2/math.Abs(heading.X), engine.ScreenHeight/2/math.Abs(heading.Y))
 
 	/* create the position vector */
-	pos := engine.Vector{
-		X: target.X + math.Cos(angle)*r,
-		Y: target.Y + math.Sin(angle)*r,
-	}
+	pos := target.Add(heading.Scale(r))
 
 	/* give meteor random velocity */
-	velocity := baseVelocity + rand.Float64()*1.5
+	velocity := baseVelocity + rng.Float64()*meteorSpeedSpread
 
-	/* create and normalize direction vector */
-	direction := engine.Vector{
-		X: target.X - pos.X,
-		Y: target.Y - pos.Y,
-	}
-	normalizedDirection := direction.Normalize()
+	/* head for the target at that velocity */
+	movement := target.Sub(pos).Normalize().Scale(velocity)
 
-	/* create movement vector */
-	movement := engine.Vector{
-		X: normalizedDirection.X * velocity,
-		Y: normalizedDirection.Y * velocity,
-	}
+	m := newMeteor(rng, MeteorLarge, pos, movement)
+	m.angle = angle
+
+	/* straddle the seam, drifting in from both sides of it */
+	m.MoveTo(engine.CenterSprite(engine.Wrap(pos), m.Sprite))
 
+	return m
+}
+
+func newMeteor(rng *rand.Rand, size MeteorSize, pos, movement engine.Vector) *Meteor {
 	/* assign a sprite to the meteor */
-	sprite := sprites[rand.Intn(len(sprites))]
+	sprites := size.sprites()
+	sprite := sprites[rng.Intn(len(sprites))]
 
 	/* create the collision object */
-	meteorObj := engine.CircleFor(sprite, pos)
+	meteorObj := engine.HitboxFor(sprite)
 
 	/* create a meteor object and return */
 	m := &Meteor{
 		Position:      pos,
-		angle:         angle,
+		previous:      pos,
 		Movement:      movement,
-		rotationSpeed: rotationSpeedMin + rand.Float64()*(rotationSpeedMax-rotationSpeedMin),
+		rotationSpeed: rotationSpeedMin + rng.Float64()*(rotationSpeedMax-rotationSpeedMin),
+		Size:          size,
 		Sprite:        sprite,
 		Obj:           meteorObj,
+		hullSprite:    sprite,
 	}
 
-	m.Obj.SetPosition(pos.X, pos.Y)
-	m.Obj.Tags().Set(engine.TagMeteor | sizeTag)
-	m.Obj.SetData(&engine.ObjectData{Index: index})
+	m.placeHitbox()
+	m.Obj.Tags().Set(engine.TagMeteor | size.tag())
 
 	return m
 }
 
-func (m *Meteor) Draw(screen *ebiten.Image) {
-	engine.DrawSprite(screen, m.Sprite, m.Position, m.rotation)
+/*
+Split breaks the meteor into the next size down. impact is the unit
+direction of the shot; each piece keeps the parent's momentum, is pushed
+along the shot and fans out to either side of it. Small meteors have nothing
+to split into and return nil.
+*/
+func (m *Meteor) Split(rng *rand.Rand, impact engine.Vector) []*Meteor {
+	if m.Size == MeteorSmall {
+		return nil
+	}
+
+	centre := engine.Vector{
+		X: m.Position.X + float64(m.hullSprite.Bounds().Dx())/2,
+		Y: m.Position.Y + float64(m.hullSprite.Bounds().Dy())/2,
+	}
+
+	heading := m.Movement.Add(impact.Scale(splitImpulse)).Scale(splitSpeedUp)
+
+	children := make([]*Meteor, splitChildren)
+	for i := range children {
+		/* alternate sides of the shot: -1, 1, -1, ... */
+		side := float64(2*(i%2) - 1)
+
+		child := newMeteor(rng, m.Size+1, engine.Vector{}, heading.Rotate(side*splitSpread))
+
+		/* set the pieces side by side across the line of fire so they do not overlap */
+		w, h := float64(child.Sprite.Bounds().Dx()), float64(child.Sprite.Bounds().Dy())
+		offset := impact.Rotate(side * math.Pi / 2)
+		child.MoveTo(engine.Vector{
+			X: centre.X + offset.X*w/2 - w/2,
+			Y: centre.Y + offset.Y*h/2 - h/2,
+		})
+
+		children[i] = child
+	}
+
+	return children
+}
+
+func (m *Meteor) Draw(screen *ebiten.Image, alpha float64) {
+	pos := engine.Interpolate(m.previous, m.Position, alpha)
+	engine.DrawWrapped(screen, m.Sprite, pos, m.previousSpin+(m.rotation-m.previousSpin)*alpha)
 }
 
-func (m *Meteor) Update() {
-	dx := m.Movement.X
-	dy := m.Movement.Y
+func (m *Meteor) Update(dt float64) {
+	m.previous = m.Position
+	m.previousSpin = m.rotation
+
+	m.Position = m.Position.Add(m.Movement.Scale(dt))
+	m.rotation += m.rotationSpeed * dt
 
-	m.Position.X += dx
-	m.Position.Y += dy
-	m.rotation += m.rotationSpeed
+	if m.explosion != nil {
+		m.explosion.Update()
+	}
 
 	m.keepOnScreen()
 
 	/* update the collision object */
-	m.Obj.SetPosition(m.Position.X, m.Position.Y)
+	m.placeHitbox()
 
 }
 
+// MoveTo puts the meteor and its collision object at pos.
+func (m *Meteor) MoveTo(pos engine.Vector) {
+	m.Position = pos
+	m.previous = pos
+	m.placeHitbox()
+}
+
 func (m *Meteor) keepOnScreen() {
-	m.Position = engine.WrapPosition(m.Position)
-	m.Obj.SetPosition(m.Position.X, m.Position.Y)
+	m.Position = engine.WrapSprite(m.Position, m.hullSprite)
+}
+
+/* placeHitbox keeps the outline of the rock the meteor spawned as, even once its sprite shows an explosion */
+func (m *Meteor) placeHitbox() {
+	engine.PlaceHitbox(m.Obj, m.hullSprite, m.Position, m.rotation)
+}
+
+// Explode shows the meteor blowing up; it stops colliding with anything at
+// once and is removed once the explosion has played.
+func (m *Meteor) Explode() {
+	if m.Size == MeteorSmall {
+		m.Sprite = assets.ExplosionSmallSprite
+	} else {
+		m.Sprite = assets.ExplosionSprite
+	}
+
+	m.explosion = engine.NewTimer(explosionTime)
+
+	/* the wreck stays in the space while it plays out, but nothing finds it there any more */
+	m.Obj.Tags().Unset(engine.TagMeteor)
+}
+
+func (m *Meteor) IsExploding() bool {
+	return m.explosion != nil
+}
+
+func (m *Meteor) Dead() bool {
+	return m.explosion != nil && m.explosion.IsReady()
+}
+
+func (m *Meteor) Collider() resolv.IShape {
+	return m.Obj
+}
+
+func (m *Meteor) Layer() Layer {
+	return LayerMeteors
 }
@@ -1,11 +1,10 @@
 package entity
 
 import (
+	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
-	"math/rand"
+	"go-asteroids/internal/input"
 	"time"
-
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
 const (
@@ -15,8 +14,8 @@ const (
 	hyperspaceMaxTries = 32
 )
 
-func (p *Player) useShield() {
-	if ebiten.IsKeyPressed(ebiten.KeyS) && !p.IsShielded && p.ShieldsRemaining > 0 {
+func (p *Player) useShield(in *input.Input) {
+	if in.IsPressed(input.Shield) && !p.IsShielded && p.ShieldsRemaining > 0 {
 		p.scene.PlayShieldSound()
 
 		p.IsShielded = true
@@ -36,12 +35,12 @@ func (p *Player) useShield() {
 	}
 }
 
-func (p *Player) hyperspace() {
+func (p *Player) hyperspace(in *input.Input) {
 	if p.hyperspaceTimer != nil {
 		p.hyperspaceTimer.Update()
 	}
 
-	if !ebiten.IsKeyPressed(ebiten.KeyH) || !p.HyperspaceReady() {
+	if !in.IsPressed(input.Hyperspace) || !p.HyperspaceReady() {
 		return
 	}
 
@@ -59,22 +58,40 @@ func (p *Player) hyperspace() {
 // teleports to a random collision-free position, giving up after a maximum number of tries
 func (p *Player) jumpToSafeSpot() bool {
 	for range hyperspaceMaxTries {
-		x := float64(rand.Intn(engine.ScreenWidth))
-		y := float64(rand.Intn(engine.ScreenHeight))
+		x := float64(p.rng.Intn(engine.ScreenWidth))
+		y := float64(p.rng.Intn(engine.ScreenHeight))
 
-		p.PlayerObj.SetPosition(x, y)
+		engine.PlaceHitbox(p.PlayerObj, assets.PlayerSprite, engine.Vector{X: x, Y: y}, p.Rotation)
 
 		if !engine.CheckCollision(p.PlayerObj) {
+			/* a jump is drawn as a jump, not a streak across the screen */
 			p.Position = engine.Vector{X: x, Y: y}
+			p.previous = p.Position
 			return true
 		}
 	}
 
 	/* no safe spot found; stay put */
-	p.PlayerObj.SetPosition(p.Position.X, p.Position.Y)
+	p.placeHitbox()
 	return false
 }
 
 func (p *Player) HyperspaceReady() bool {
 	return p.hyperspaceTimer == nil || p.hyperspaceTimer.IsReady()
 }
+
+// HyperspaceCharge is how far hyperspace has recharged since the last jump, from 0 to 1.
+func (p *Player) HyperspaceCharge() float64 {
+	if p.hyperspaceTimer == nil {
+		return 1
+	}
+	return p.hyperspaceTimer.Progress()
+}
+
+// ShieldLeft is how much of the raised shield's time remains, from 1 down to 0.
+func (p *Player) ShieldLeft() float64 {
+	if !p.IsShielded || p.shieldTimer == nil {
+		return 0
+	}
+	return 1 - p.shieldTimer.Progress()
+}
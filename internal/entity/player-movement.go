@@ -2,52 +2,112 @@ package entity
 
 import (
 	"go-asteroids/internal/engine"
+	"go-asteroids/internal/input"
 	"math"
 	"time"
-
-	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
 const (
 	rotationPerSecond  = math.Pi
 	exhaustSpawnOffset = -50.0
 	driftTime          = time.Second * 30
+
+	/* the tick rate the arcade handling was tuned at */
+	arcadeTickRate = 60
+
+	/* reverse thrust is weaker than the main engine */
+	reverseThrust = 0.5
+)
+
+// Handling tunes how the ship flies. Newtonian handling integrates a velocity
+// every tick: thrust accelerates the ship along its heading, drag bleeds off
+// a fraction of its speed each second and the speed is capped at MaxSpeed.
+// Arcade handling ignores the numbers and flies the original way.
+type Handling struct {
+	Arcade bool
+
+	Thrust   float64 // pixels per second squared
+	Drag     float64 // fraction of speed lost per second, from 0 to 1
+	MaxSpeed float64 // pixels per second
+}
+
+var (
+	NewtonianHandling = Handling{Thrust: 400, Drag: 0.4, MaxSpeed: 480}
+	ArcadeHandling    = Handling{Arcade: true}
 )
 
 type motion struct {
+	/* newtonian flight, in pixels per second */
+	momentum engine.Vector
+
+	/* arcade flight */
 	acceleration float64
 	velocity     float64
 	driftAngle   float64
 	driftTimer   *engine.Timer
 }
 
-func (p *Player) rotate() {
-	speed := rotationPerSecond / float64(ebiten.TPS())
+func (p *Player) rotate(in *input.Input, dt float64) {
+	speed := rotationPerSecond * dt
+
+	p.Rotation += speed * in.Turn()
+}
+
+/* move applies thrust, drift, and reverse, then syncs the collision object */
+func (p *Player) move(in *input.Input, dt float64) {
+	if p.Handling.Arcade {
+		p.accelerate(in, dt)
+		p.isDoneAccelerating(in)
+		p.drift(dt)
+		p.reverse(in, dt)
+	} else {
+		p.fly(in, dt)
+	}
+	p.isDoneReversing(in)
+	p.updateExhaustSprite(in)
+
+	p.placeHitbox()
+}
+
+/* fly integrates newtonian motion: turning changes where thrust pushes, never where the ship is already going */
+func (p *Player) fly(in *input.Input, dt float64) {
+	h := p.Handling
+
+	thrust := 0.0
+	if in.IsPressed(input.Thrust) {
+		thrust += h.Thrust * in.Throttle()
+	}
+	if in.IsPressed(input.Reverse) {
+		thrust -= h.Thrust * reverseThrust
+	}
+
+	if thrust != 0 {
+		p.motion.momentum = p.motion.momentum.Add(engine.Heading(p.Rotation).Scale(thrust * dt))
 
-	if ebiten.IsKeyPressed(ebiten.KeyLeft) {
-		p.Rotation -= speed
+		p.showExhaust()
+		p.scene.PlayThrust()
 	}
 
-	if ebiten.IsKeyPressed(ebiten.KeyRight) {
-		p.Rotation += speed
+	if in.IsJustReleased(input.Thrust) {
+		p.scene.PauseThrust()
 	}
+
+	/* scale drag by the tick length so the ship slows the same at any tick rate */
+	friction := math.Pow(1-min(max(h.Drag, 0), 1), dt)
+	p.motion.momentum = p.motion.momentum.Scale(friction).Clamp(h.MaxSpeed)
+
+	p.Position = p.Position.Add(p.motion.momentum.Scale(dt))
+
+	p.keepOnScreen()
 }
 
-/* move applies thrust, drift, and reverse, then syncs the collision object */
-func (p *Player) move() {
-	p.accelerate()
-	p.isDoneAccelerating()
-	p.drift()
-	p.reverse()
-	p.isDoneReversing()
-	p.updateExhaustSprite()
-
-	p.PlayerObj.SetPosition(p.Position.X, p.Position.Y)
+/* arcadeSteps scales arcade handling, tuned as distances per tick at arcadeTickRate, to a step of dt */
+func arcadeSteps(dt float64) float64 {
+	return dt * arcadeTickRate
 }
 
-func (p *Player) accelerate() {
-	if !ebiten.IsKeyPressed(ebiten.KeyUp) {
+func (p *Player) accelerate(in *input.Input, dt float64) {
+	if !in.IsPressed(input.Thrust) {
 		return
 	}
 
@@ -65,22 +125,20 @@ func (p *Player) accelerate() {
 
 	p.motion.velocity = p.motion.acceleration
 
-	/* move in the direction we are pointing */
-	dx := math.Sin(p.Rotation) * p.motion.acceleration
-	dy := math.Cos(p.Rotation) * -p.motion.acceleration
+	/* move in the direction we are pointing, scaled by how hard the stick is pushed */
+	thrust := p.motion.acceleration * in.Throttle() * arcadeSteps(dt)
 
 	p.showExhaust()
 
 	/* move player */
-	p.Position.X += dx
-	p.Position.Y += dy
+	p.Position = p.Position.Add(engine.Heading(p.Rotation).Scale(thrust))
 
 	/* play thrust sound */
 	p.scene.PlayThrust()
 }
 
-func (p *Player) isDoneAccelerating() {
-	if !inpututil.IsKeyJustReleased(ebiten.KeyUp) {
+func (p *Player) isDoneAccelerating(in *input.Input) {
+	if !in.IsJustReleased(input.Thrust) {
 		return
 	}
 
@@ -102,7 +160,7 @@ func (p *Player) isDoneAccelerating() {
 	p.motion.driftAngle = p.Rotation
 }
 
-func (p *Player) drift() {
+func (p *Player) drift(dt float64) {
 	if p.motion.driftTimer == nil {
 		return
 	}
@@ -110,11 +168,10 @@ func (p *Player) drift() {
 	p.keepOnScreen()
 	p.motion.driftTimer.Update()
 
-	decelerationSpeed := p.motion.velocity / float64(ebiten.TPS()) * 4
+	decelerationSpeed := p.motion.velocity * dt * 4
 
-	p.Position.X += math.Sin(p.motion.driftAngle) * decelerationSpeed
-	p.Position.Y += math.Cos(p.motion.driftAngle) * -decelerationSpeed
-	p.PlayerObj.SetPosition(p.Position.X, p.Position.Y)
+	p.Position = p.Position.Add(engine.Heading(p.motion.driftAngle).Scale(decelerationSpeed))
+	p.placeHitbox()
 
 	if p.motion.driftTimer.IsReady() {
 		p.motion.driftTimer = nil
@@ -122,43 +179,40 @@ func (p *Player) drift() {
 	}
 }
 
-func (p *Player) reverse() {
-	if !ebiten.IsKeyPressed(ebiten.KeyDown) {
+func (p *Player) reverse(in *input.Input, dt float64) {
+	if !in.IsPressed(input.Reverse) {
 		return
 	}
 
 	p.motion.driftTimer = nil
 
 	p.keepOnScreen()
-	dx := math.Sin(p.Rotation) * -3
-	dy := math.Cos(p.Rotation) * 3
 
 	p.showExhaust()
 
-	/* move player */
-	p.Position.X += dx
-	p.Position.Y += dy
+	/* move player backwards */
+	p.Position = p.Position.Add(engine.Heading(p.Rotation).Scale(-3 * arcadeSteps(dt)))
 
-	p.PlayerObj.SetPosition(p.Position.X, p.Position.Y)
+	p.placeHitbox()
 
 	p.scene.PlayThrust()
 }
 
-func (p *Player) isDoneReversing() {
-	if inpututil.IsKeyJustReleased(ebiten.KeyDown) {
+func (p *Player) isDoneReversing(in *input.Input) {
+	if in.IsJustReleased(input.Reverse) {
 		p.scene.PauseThrust()
 	}
 }
 
-func (p *Player) updateExhaustSprite() {
-	if !ebiten.IsKeyPressed(ebiten.KeyUp) && !ebiten.IsKeyPressed(ebiten.KeyDown) {
+func (p *Player) updateExhaustSprite(in *input.Input) {
+	if !in.IsPressed(input.Thrust) && !in.IsPressed(input.Reverse) {
 		p.scene.SetExhaust(nil)
 	}
 }
 
 func (p *Player) keepOnScreen() {
-	p.Position = engine.WrapPosition(p.Position)
-	p.PlayerObj.SetPosition(p.Position.X, p.Position.Y)
+	p.Position = engine.WrapSprite(p.Position, p.Sprite)
+	p.placeHitbox()
 }
 
 func (p *Player) showExhaust() {
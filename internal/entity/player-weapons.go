@@ -2,9 +2,8 @@ package entity
 
 import (
 	"go-asteroids/internal/engine"
+	"go-asteroids/internal/input"
 	"time"
-
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
 const (
@@ -32,9 +31,9 @@ func (w *weapon) update() {
 	w.shootCooldown.Update()
 }
 
-func (w *weapon) fire() (int, bool) {
+func (w *weapon) fire() bool {
 	if !w.burstCooldown.IsReady() || !w.shootCooldown.IsReady() {
-		return 0, false
+		return false
 	}
 
 	w.shootCooldown.Reset()
@@ -43,24 +42,23 @@ func (w *weapon) fire() (int, bool) {
 	if w.shotsFired > maxShotsPerBurst {
 		w.burstCooldown.Reset()
 		w.shotsFired = 0
-		return 0, false
+		return false
 	}
 
-	return w.shotsFired, true
+	return true
 }
 
-func (p *Player) fireLasers() {
+func (p *Player) fireLasers(in *input.Input) {
 	p.weapon.update()
 
-	if !ebiten.IsKeyPressed(ebiten.KeySpace) {
+	if !in.IsPressed(input.Fire) {
 		return
 	}
 
-	shot, ok := p.weapon.fire()
-	if !ok {
+	if !p.weapon.fire() {
 		return
 	}
 
 	p.scene.SpawnLaser(p.spawnPoint(laserSpawnOffset), p.Rotation)
-	p.scene.PlayLaserSound(shot)
+	p.scene.PlayLaserSound()
 }
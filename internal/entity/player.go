@@ -3,7 +3,8 @@ package entity
 import (
 	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
-	"math"
+	"go-asteroids/internal/input"
+	"math/rand"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -17,13 +18,19 @@ const (
 
 type Player struct {
 	scene     Scene
+	rng       *rand.Rand
 	Sprite    *ebiten.Image
 	Rotation  float64
 	Position  engine.Vector
-	PlayerObj *resolv.Circle
+	PlayerObj *resolv.ConvexPolygon
 
-	motion motion
-	weapon weapon
+	/* where the ship was a step ago, to draw it between steps */
+	previous         engine.Vector
+	previousRotation float64
+
+	Handling Handling
+	motion   motion
+	weapon   weapon
 
 	IsShielded       bool
 	shieldTimer      *engine.Timer
@@ -32,13 +39,12 @@ type Player struct {
 	IsDying        bool
 	IsDead         bool
 	DyingTimer     *engine.Timer
-	DyingCounter   int
 	LivesRemaining int
 
 	hyperspaceTimer *engine.Timer
 }
 
-func NewPlayer(scene Scene) *Player {
+func NewPlayer(scene Scene, rng *rand.Rand, handling Handling) *Player {
 	sprite := assets.PlayerSprite
 
 	/* center player on screen */
@@ -49,33 +55,51 @@ func NewPlayer(scene Scene) *Player {
 
 	p := &Player{
 		scene:            scene,
+		rng:              rng,
 		Sprite:           sprite,
 		Position:         pos,
-		PlayerObj:        engine.CircleFor(sprite, pos),
+		previous:         pos,
+		Handling:         handling,
+		PlayerObj:        engine.HitboxFor(sprite),
 		weapon:           newWeapon(),
-		DyingTimer:       engine.NewTimer(dyingAnimationAmount),
+		DyingTimer:       engine.NewRepeatingTimer(dyingAnimationAmount),
 		LivesRemaining:   numberOfLives,
 		ShieldsRemaining: numberOfShields,
 	}
 
 	p.PlayerObj.Tags().Set(engine.TagPlayer)
+	p.placeHitbox()
 
 	return p
 }
 
-func (p *Player) Draw(screen *ebiten.Image) {
-	engine.DrawSprite(screen, p.Sprite, p.Position, p.Rotation)
+/* placeHitbox lines the collision object up with the ship; it keeps the ship's outline while the sprite shows the explosion */
+func (p *Player) placeHitbox() {
+	engine.PlaceHitbox(p.PlayerObj, assets.PlayerSprite, p.Position, p.Rotation)
+}
+
+func (p *Player) Draw(screen *ebiten.Image, alpha float64) {
+	pos, rotation := p.interpolate(alpha)
+	engine.DrawWrapped(screen, p.Sprite, pos, rotation)
 }
 
-func (p *Player) Update() {
+/* interpolate is where the ship is drawn, alpha of the way from its previous step to its current one */
+func (p *Player) interpolate(alpha float64) (engine.Vector, float64) {
+	return engine.Interpolate(p.previous, p.Position, alpha), p.previousRotation + (p.Rotation-p.previousRotation)*alpha
+}
+
+func (p *Player) Update(in *input.Input, dt float64) {
+	p.previous = p.Position
+	p.previousRotation = p.Rotation
+
 	p.isPlayerDead()
 
-	p.rotate()
-	p.move()
+	p.rotate(in, dt)
+	p.move(in, dt)
 
-	p.useShield()
-	p.fireLasers()
-	p.hyperspace()
+	p.useShield(in)
+	p.fireLasers(in)
+	p.hyperspace(in)
 }
 
 func (p *Player) isPlayerDead() {
@@ -87,8 +111,10 @@ func (p *Player) isPlayerDead() {
 func (p *Player) spawnPoint(distance float64) engine.Vector {
 	bounds := p.Sprite.Bounds()
 
-	return engine.Vector{
-		X: p.Position.X + float64(bounds.Dx())/2 + math.Sin(p.Rotation)*distance,
-		Y: p.Position.Y + float64(bounds.Dy())/2 - math.Cos(p.Rotation)*distance,
+	centre := engine.Vector{
+		X: p.Position.X + float64(bounds.Dx())/2,
+		Y: p.Position.Y + float64(bounds.Dy())/2,
 	}
+
+	return centre.Add(engine.Heading(p.Rotation).Scale(distance))
 }
@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"go-asteroids/internal/engine"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+)
+
+/*
+Registry owns the entities in play. It numbers each one as it is added,
+keeps its shape in the collision space under that number, and removes it
+once it is dead or has been removed. Removal is deferred: a removed entity
+leaves the space at once, so it stops colliding, but is only dropped by the
+next Sweep, so entities can be removed while others are being visited.
+Entities are visited in the order they were added, which keeps anything that
+draws from the random sequence reproducible.
+*/
+type Registry struct {
+	space    *resolv.Space
+	entities map[int]Entity
+	order    []int
+	removed  map[int]bool
+	lastID   int
+}
+
+func NewRegistry(space *resolv.Space) *Registry {
+	return &Registry{
+		space:    space,
+		entities: make(map[int]Entity),
+		removed:  make(map[int]bool),
+	}
+}
+
+// Add registers e and puts its shape in the space, returning its ID.
+func (r *Registry) Add(e Entity) int {
+	r.lastID++
+	id := r.lastID
+
+	e.Collider().SetData(&engine.ObjectData{Index: id})
+	r.space.Add(e.Collider())
+	r.entities[id] = e
+	r.order = append(r.order, id)
+
+	return id
+}
+
+// Remove takes e out of the space now and out of the registry at the next
+// Sweep. Removing an entity twice, or one never added, does nothing.
+func (r *Registry) Remove(e Entity) {
+	id, ok := r.idOf(e)
+	if !ok || r.removed[id] {
+		return
+	}
+
+	r.space.Remove(e.Collider())
+	r.removed[id] = true
+}
+
+/* idOf finds the ID e was added under, from the data on its shape */
+func (r *Registry) idOf(e Entity) (int, bool) {
+	data, ok := e.Collider().Data().(*engine.ObjectData)
+	if !ok || r.entities[data.Index] != e {
+		return 0, false
+	}
+
+	return data.Index, true
+}
+
+// Sweep drops the entities that were removed or have died since the last one.
+func (r *Registry) Sweep() {
+	r.order = slices.DeleteFunc(r.order, func(id int) bool {
+		e := r.entities[id]
+		if !r.removed[id] && !e.Dead() {
+			return false
+		}
+
+		if !r.removed[id] {
+			r.space.Remove(e.Collider())
+		}
+		delete(r.entities, id)
+		delete(r.removed, id)
+
+		return true
+	})
+}
+
+// Clear removes every entity at once, as at the start of a life.
+func (r *Registry) Clear() {
+	for _, e := range r.entities {
+		r.space.Remove(e.Collider())
+	}
+
+	r.entities = make(map[int]Entity)
+	r.removed = make(map[int]bool)
+	r.order = nil
+	r.lastID = 0
+}
+
+// Update steps every entity still in play.
+func (r *Registry) Update(dt float64) {
+	for _, e := range r.live() {
+		e.Update(dt)
+	}
+}
+
+// Draw draws every entity still in play, layer by layer.
+func (r *Registry) Draw(screen *ebiten.Image, alpha float64) {
+	entities := r.live()
+	slices.SortStableFunc(entities, func(a, b Entity) int {
+		return int(a.Layer() - b.Layer())
+	})
+
+	for _, e := range entities {
+		e.Draw(screen, alpha)
+	}
+}
+
+/* live is the entities not yet removed, in the order they were added */
+func (r *Registry) live() []Entity {
+	entities := make([]Entity, 0, len(r.order))
+	for _, id := range r.order {
+		if !r.removed[id] {
+			entities = append(entities, r.entities[id])
+		}
+	}
+
+	return entities
+}
+
+// All returns the entities of type T still in play, in the order they were added.
+func All[T Entity](r *Registry) []T {
+	var all []T
+	for _, e := range r.live() {
+		if t, ok := e.(T); ok {
+			all = append(all, t)
+		}
+	}
+
+	return all
+}
+
+// Count is how many entities of type T are still in play.
+func Count[T Entity](r *Registry) int {
+	return len(All[T](r))
+}
+
+// Owner finds the entity of type T that obj, a shape from the registry's
+// space, belongs to.
+func Owner[T Entity](r *Registry, obj resolv.IShape) (T, bool) {
+	var none T
+
+	data, ok := obj.Data().(*engine.ObjectData)
+	if !ok || r.removed[data.Index] {
+		return none, false
+	}
+
+	t, ok := r.entities[data.Index].(T)
+	return t, ok
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+)
+
+/* probe is the smallest entity: it records when it is drawn and dies when told to */
+type probe struct {
+	name  string
+	layer Layer
+	obj   *resolv.ConvexPolygon
+	dead  bool
+	steps int
+	drawn *[]string
+}
+
+func newProbe(name string, layer Layer, drawn *[]string) *probe {
+	return &probe{name: name, layer: layer, obj: resolv.NewRectangle(10, 10, 4, 4), drawn: drawn}
+}
+
+func (p *probe) Update(float64)              { p.steps++ }
+func (p *probe) Draw(*ebiten.Image, float64) { *p.drawn = append(*p.drawn, p.name) }
+func (p *probe) Collider() resolv.IShape     { return p.obj }
+func (p *probe) Dead() bool                  { return p.dead }
+func (p *probe) Layer() Layer                { return p.layer }
+
+func TestRegistryOwnsSpaceMembership(t *testing.T) {
+	space := resolv.NewSpace(100, 100, 16, 16)
+	r := NewRegistry(space)
+
+	var drawn []string
+	a, b := newProbe("a", LayerMeteors, &drawn), newProbe("b", LayerMeteors, &drawn)
+	r.Add(a)
+	r.Add(b)
+
+	if got := len(space.Shapes()); got != 2 {
+		t.Fatalf("space holds %d shapes after two adds; want 2", got)
+	}
+	if got, ok := Owner[*probe](r, b.obj); !ok || got != b {
+		t.Fatalf("Owner(b's shape) = %v, %v; want b", got, ok)
+	}
+
+	/* a removed entity leaves the space at once but the registry only at the sweep */
+	r.Remove(a)
+	r.Remove(a)
+	if got := len(space.Shapes()); got != 1 {
+		t.Errorf("space holds %d shapes after a removal; want 1", got)
+	}
+	if _, ok := Owner[*probe](r, a.obj); ok {
+		t.Error("a removed entity still owns its shape")
+	}
+	if got := All[*probe](r); !slices.Equal(got, []*probe{b}) {
+		t.Errorf("All = %v; want only b", got)
+	}
+
+	b.dead = true
+	r.Sweep()
+	if got := len(space.Shapes()); got != 0 || Count[*probe](r) != 0 {
+		t.Errorf("after the sweep the space holds %d shapes and the registry %d entities; want none", got, Count[*probe](r))
+	}
+}
+
+func TestRegistryVisitsInOrder(t *testing.T) {
+	r := NewRegistry(resolv.NewSpace(100, 100, 16, 16))
+
+	var drawn []string
+	for _, p := range []*probe{
+		newProbe("alien", LayerAliens, &drawn),
+		newProbe("rock", LayerMeteors, &drawn),
+		newProbe("shot", LayerLasers, &drawn),
+		newProbe("pebble", LayerMeteors, &drawn),
+	} {
+		r.Add(p)
+	}
+
+	r.Update(1)
+	for _, p := range All[*probe](r) {
+		if p.steps != 1 {
+			t.Errorf("%s stepped %d times; want 1", p.name, p.steps)
+		}
+	}
+
+	/* lower layers first, and arrival order within a layer */
+	r.Draw(nil, 0)
+	if want := []string{"rock", "pebble", "shot", "alien"}; !slices.Equal(drawn, want) {
+		t.Errorf("drawn %v; want %v", drawn, want)
+	}
+}
@@ -11,6 +11,6 @@ type Scene interface {
 	SetPlayerDead()
 	PlayThrust()
 	PauseThrust()
-	PlayLaserSound(shot int)
+	PlayLaserSound()
 	PlayShieldSound()
 }
@@ -29,11 +29,18 @@ func NewShield(player *Player) *Shield {
 		player:   player,
 		rotation: player.Rotation,
 		sprite:   sprite,
-		Obj:      resolv.NewCircle(0, 0, halfW),
+		Obj:      shieldObj(halfW),
 	}
 }
 
-func (s *Shield) Update() {
+func shieldObj(radius float64) *resolv.Circle {
+	obj := engine.HitCircle(radius)
+	obj.Tags().Set(engine.TagShield)
+
+	return obj
+}
+
+func (s *Shield) Update(_ float64) {
 	/* offset for shield */
 	deltaX := float64(s.sprite.Bounds().Dx()-s.player.Sprite.Bounds().Dx()) * 0.5
 	deltaY := float64(s.sprite.Bounds().Dy()-s.player.Sprite.Bounds().Dy()) * 0.5
@@ -45,10 +52,18 @@ func (s *Shield) Update() {
 
 	s.position = pos
 	s.rotation = s.player.Rotation
-	s.Obj.Move(pos.X, pos.Y)
+
+	/* the circle is centred on the sprite, like the ship's hitbox */
+	bounds := s.sprite.Bounds()
+	s.Obj.SetPosition(pos.X+float64(bounds.Dx())/2, pos.Y+float64(bounds.Dy())/2)
 
 }
 
-func (s *Shield) Draw(screen *ebiten.Image) {
-	engine.DrawSprite(screen, s.sprite, s.position, s.rotation)
+func (s *Shield) Draw(screen *ebiten.Image, alpha float64) {
+	/* the shield is drawn wherever the ship is drawn */
+	pos, rotation := s.player.interpolate(alpha)
+	pos.X += s.position.X - s.player.Position.X
+	pos.Y += s.position.Y - s.player.Position.Y
+
+	engine.DrawWrapped(screen, s.sprite, pos, rotation)
 }
@@ -16,12 +16,12 @@ type Star struct {
 	brightness float32
 }
 
-func NewStar() *Star {
+func NewStar(rng *rand.Rand) *Star {
 	return &Star{
-		x:          rand.Float32() * engine.ScreenWidth,
-		y:          rand.Float32() * engine.ScreenHeight,
-		r:          rand.Float32() * (3 - 1),
-		brightness: rand.Float32() * 0xff,
+		x:          rng.Float32() * engine.ScreenWidth,
+		y:          rng.Float32() * engine.ScreenHeight,
+		r:          rng.Float32() * (3 - 1),
+		brightness: rng.Float32() * 0xff,
 	}
 }
 
@@ -38,11 +38,11 @@ func (s *Star) Draw(screen *ebiten.Image) {
 
 func (s *Star) Update() {}
 
-func GenerateStars(n int) []*Star {
+func GenerateStars(rng *rand.Rand, n int) []*Star {
 	var stars []*Star
 
 	for range n {
-		stars = append(stars, NewStar())
+		stars = append(stars, NewStar(rng))
 	}
 
 	return stars
@@ -2,34 +2,102 @@ package game
 
 import (
 	"go-asteroids/internal/engine"
+	"go-asteroids/internal/input"
+	"go-asteroids/internal/replay"
 	"go-asteroids/internal/scene"
+	"go-asteroids/internal/settings"
+	"go-asteroids/internal/sound"
+	"log"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 type Game struct {
+	/* Seed fixes the gameplay random sequence; zero picks a new one each run */
+	Seed int64
+	/* Replay, when set, is played back instead of showing the title */
+	Replay *replay.Replay
+	/* Settings are the loaded preferences, edited in place by the options menu */
+	Settings *settings.Settings
+
 	sceneManager *scene.SceneManager
-	input        scene.Input
+	input        *scene.Input
+	clock        engine.Clock
 }
 
 func (g *Game) Update() error {
 	if g.sceneManager == nil {
-		g.sceneManager = &scene.SceneManager{}
-		g.sceneManager.GoToScene(scene.NewTitleScene())
+		g.sceneManager = &scene.SceneManager{Sound: g.loadSound()}
+		if g.Replay != nil {
+			g.sceneManager.GoToScene(scene.NewReplayScene(g.Replay, g.Settings), scene.Cut())
+		} else {
+			g.sceneManager.GoToScene(scene.NewTitleScene(g.Seed, g.Settings), scene.Cut())
+		}
+	}
+
+	if g.input == nil {
+		g.input = input.New(loadBindings(), loadGamepadProfiles())
 	}
 
-	g.input.Update()
-	if err := g.sceneManager.Update(&g.input); err != nil {
-		return err
+	/* the simulation takes fixed steps, however often ebiten calls in */
+	g.input.Collect()
+	for range g.clock.Advance(ebiten.TPS()) {
+		g.input.Update()
+		if err := g.sceneManager.Update(g.input); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (g *Game) Draw(screen *ebiten.Image) {
-	g.sceneManager.Draw(screen)
+	g.sceneManager.Draw(screen, g.clock.Alpha())
 }
 
 func (g *Game) Layout(_, _ int) (width, height int) {
 	return engine.ScreenWidth, engine.ScreenHeight
 }
+
+/* loadBindings falls back to the default keys if the user file is unusable */
+func loadBindings() input.Bindings {
+	path, err := input.BindingsPath()
+	if err != nil {
+		log.Println("Error locating key bindings", err)
+		return input.DefaultBindings()
+	}
+
+	b, err := input.LoadBindings(path)
+	if err != nil {
+		log.Println("Error loading key bindings", err)
+	}
+
+	return b
+}
+
+/* loadGamepadProfiles falls back to the stock pad layout if the user file is unusable */
+func loadGamepadProfiles() input.GamepadProfiles {
+	path, err := input.GamepadProfilesPath()
+	if err != nil {
+		log.Println("Error locating gamepad bindings", err)
+		return input.GamepadProfiles{}
+	}
+
+	p, err := input.LoadGamepadProfiles(path)
+	if err != nil {
+		log.Println("Error loading gamepad bindings", err)
+	}
+
+	return p
+}
+
+/* loadSound creates the one sound manager for the whole game; a sound that fails to load stays silent */
+func (g *Game) loadSound() *sound.Manager {
+	m := sound.NewManager()
+	if err := scene.LoadSounds(m); err != nil {
+		log.Println("Error loading sounds", err)
+	}
+	g.Settings.ApplySound(m)
+
+	return m
+}
@@ -1,84 +1,194 @@
 package highscore
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"go-asteroids/internal/storage"
+	"io/fs"
 	"os"
-	"os/user"
-	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
-// Get reads the persisted high score, creating the store file if needed.
-func Get() (int, error) {
-	/* get user name */
-	u, err := user.Current()
+const (
+	fileName       = "high-scores.json"
+	legacyFileName = "high-score.txt"
+	version        = 1
 
+	// MaxEntries is how many scores the table keeps.
+	MaxEntries = 10
+)
+
+// ErrCorrupt is returned by Load when the store exists but cannot be read as a table.
+var ErrCorrupt = errors.New("highscore: corrupt high score file")
+
+// ErrNewer is returned when the store was written by a newer version of the
+// game; Save leaves such a store alone rather than downgrade it.
+var ErrNewer = errors.New("highscore: high score file from a newer version")
+
+// Entry is one ranked run.
+type Entry struct {
+	Initials string    `json:"initials"`
+	Score    int       `json:"score"`
+	Level    int       `json:"level"`
+	Date     time.Time `json:"date"`
+	Seed     int64     `json:"seed"`
+}
+
+// Table is the leaderboard, best score first.
+type Table []Entry
+
+type file struct {
+	Version int   `json:"version"`
+	Entries Table `json:"entries"`
+}
+
+// Load reads the leaderboard. A missing store is an empty table, after
+// migrating any single-number high-score.txt left by older versions. A corrupt
+// store also reads as empty alongside ErrCorrupt, and the next Save moves it
+// aside to a .bak file; a newer version's store reads as empty alongside ErrNewer.
+func Load() (Table, error) {
+	path, err := storage.Path(fileName)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	path := ""
-	switch runtime.GOOS {
-	case "darwin":
-		path = fmt.Sprintf("/Users/%s/Library/Application Support/Go Asteroids", u.Username)
-	case "windows":
-		path = fmt.Sprintf("/C:\\Useres\\%s\\AppData", u.Username)
-	default:
-		path = fmt.Sprintf("/users/%s", u.Username)
+	t, err := read(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return migrate()
 	}
 
-	if _, err := os.Stat(path); err != nil {
-		if err := os.Mkdir(path, 0750); err != nil {
-			return 0, err
-		}
+	return t, err
+}
+
+func read(path string) (Table, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, err := os.Stat(path + "/high-score.txt"); err != nil {
-		err := os.WriteFile(path+"/high-score.txt", []byte("0"), 0750)
-		if err != nil {
-			return 0, err
-		}
+	var f file
+	if err := json.Unmarshal(contents, &f); err != nil || f.Version < version {
+		return nil, fmt.Errorf("%w: %s", ErrCorrupt, path)
+	}
+	if f.Version > version {
+		return nil, fmt.Errorf("%w: %s (version %d)", ErrNewer, path, f.Version)
 	}
 
-	contents, err := os.ReadFile(path + "/high-score.txt")
+	return f.Entries.sorted(), nil
+}
+
+// Save writes t to the store, refusing with ErrNewer to replace a newer
+// version's table.
+func Save(t Table) error {
+	path, err := storage.Path(fileName)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
-	score := string(contents)
-	score = strings.TrimSpace(score)
+	/* a store this version cannot read is kept, so nothing is lost by writing over it */
+	switch _, err := read(path); {
+	case errors.Is(err, ErrNewer):
+		return err
+	case errors.Is(err, ErrCorrupt):
+		if err := os.Rename(path, path+".bak"); err != nil {
+			return err
+		}
+	case err != nil && !errors.Is(err, fs.ErrNotExist):
+		return err
+	}
 
-	s, err := strconv.Atoi(string(score))
+	contents, err := json.MarshalIndent(file{Version: version, Entries: t}, "", "  ")
 	if err != nil {
-		return 0, err
+		return err
 	}
 
-	return s, nil
+	return storage.WriteFile(path, contents)
 }
 
-// Update writes score to the persisted high-score store.
-func Update(score int) error {
-	/* get user name */
-	u, err := user.Current()
+/* migrate turns a legacy high-score.txt into a one-entry table and retires the old file */
+func migrate() (Table, error) {
+	path, err := storage.Path(legacyFileName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	path := ""
-	switch runtime.GOOS {
-	case "darwin":
-		path = fmt.Sprintf("/Users/%s/Library/Application Support/Go Asteroids/high-score.txt", u.Username)
-	case "windows":
-		path = fmt.Sprintf("/C:\\Useres\\%s\\AppData\\high-score.txt", u.Username)
-	default:
-		path = fmt.Sprintf("/users/%s/high-score.txt", u.Username)
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	s := fmt.Sprintf("%d", score)
-	if err := os.WriteFile(path, []byte(s), 0750); err != nil {
-		return err
+	score, err := strconv.Atoi(strings.TrimSpace(string(contents)))
+	if err != nil || score <= 0 {
+		/* nothing worth keeping */
+		return nil, os.Remove(path)
+	}
+
+	date := time.Now()
+	if info, err := os.Stat(path); err == nil {
+		date = info.ModTime()
+	}
+
+	t := Table{{Initials: "???", Score: score, Date: date}}
+	if err := Save(t); err != nil {
+		return t, err
+	}
+
+	return t, os.Remove(path)
+}
+
+// Best is the top score, or zero for an empty table.
+func (t Table) Best() int {
+	if len(t) == 0 {
+		return 0
+	}
+	return t[0].Score
+}
+
+// Qualifies reports whether score would earn a place in the table.
+func (t Table) Qualifies(score int) bool {
+	if score <= 0 {
+		return false
+	}
+	return len(t) < MaxEntries || score > t[len(t)-1].Score
+}
+
+// Insert places e in rank order, dropping whatever falls off the bottom, and
+// returns the new table with e's index in it (-1 if it did not qualify).
+func (t Table) Insert(e Entry) (Table, int) {
+	if !t.Qualifies(e.Score) {
+		return t, -1
+	}
+
+	/* ties go below existing entries, so the earlier run keeps its rank */
+	rank := len(t)
+	for i, existing := range t {
+		if e.Score > existing.Score {
+			rank = i
+			break
+		}
+	}
+
+	t = slices.Insert(slices.Clone(t), rank, e)
+	if len(t) > MaxEntries {
+		t = t[:MaxEntries]
 	}
 
-	return nil
+	return t, rank
+}
+
+func (t Table) sorted() Table {
+	slices.SortStableFunc(t, func(a, b Entry) int {
+		return b.Score - a.Score
+	})
+	if len(t) > MaxEntries {
+		t = t[:MaxEntries]
+	}
+	return t
 }
@@ -0,0 +1,131 @@
+package highscore
+
+import (
+	"errors"
+	"go-asteroids/internal/storage"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func useTempStore(t *testing.T) string {
+	dir := t.TempDir()
+	storage.SetDir(dir)
+	t.Cleanup(func() { storage.SetDir("") })
+
+	return dir
+}
+
+func TestLoadWithoutStore(t *testing.T) {
+	useTempStore(t)
+
+	table, err := Load()
+	if err != nil || len(table) != 0 {
+		t.Fatalf("Load() = %v, %v; want empty, nil", table, err)
+	}
+}
+
+func TestSaveThenLoad(t *testing.T) {
+	useTempStore(t)
+
+	table, _ := Table{}.Insert(Entry{Initials: "AAA", Score: 50, Level: 2, Seed: 42})
+	if err := Save(table); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Initials != "AAA" || got[0].Score != 50 || got[0].Seed != 42 {
+		t.Fatalf("Load() = %+v, want the saved entry", got)
+	}
+}
+
+func TestLoadMigratesLegacyScore(t *testing.T) {
+	dir := useTempStore(t)
+
+	legacy := filepath.Join(dir, legacyFileName)
+	if err := os.WriteFile(legacy, []byte("1234\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	table, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Best() != 1234 {
+		t.Fatalf("Best() = %d, want the migrated 1234", table.Best())
+	}
+	if _, err := os.Stat(legacy); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("legacy file should be removed after migration, stat err = %v", err)
+	}
+}
+
+func TestLoadCorruptStore(t *testing.T) {
+	dir := useTempStore(t)
+
+	if err := os.WriteFile(filepath.Join(dir, fileName), []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	table, err := Load()
+	if !errors.Is(err, ErrCorrupt) || len(table) != 0 {
+		t.Fatalf("Load() = %v, %v; want empty, ErrCorrupt", table, err)
+	}
+
+	/* the unreadable store survives as a backup once a new table replaces it */
+	if err := Save(Table{{Initials: "AAA", Score: 50}}); err != nil {
+		t.Fatal(err)
+	}
+	if backup, err := os.ReadFile(filepath.Join(dir, fileName+".bak")); err != nil || string(backup) != "not json" {
+		t.Fatalf("backup = %q, %v; want the corrupt store", backup, err)
+	}
+}
+
+func TestNewerStoreIsKept(t *testing.T) {
+	dir := useTempStore(t)
+
+	path := filepath.Join(dir, fileName)
+	newer := []byte(`{"version": 2, "entries": [{"initials": "ZZZ", "score": 900}]}`)
+	if err := os.WriteFile(path, newer, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if table, err := Load(); !errors.Is(err, ErrNewer) || len(table) != 0 {
+		t.Fatalf("Load() = %v, %v; want empty, ErrNewer", table, err)
+	}
+	if err := Save(Table{{Initials: "AAA", Score: 50}}); !errors.Is(err, ErrNewer) {
+		t.Fatalf("Save() error = %v, want ErrNewer", err)
+	}
+	if got, _ := os.ReadFile(path); string(got) != string(newer) {
+		t.Fatalf("store = %s; the newer table was overwritten", got)
+	}
+}
+
+func TestInsertKeepsTopTen(t *testing.T) {
+	var table Table
+	for score := 10; score <= 100; score += 10 {
+		table, _ = table.Insert(Entry{Score: score})
+	}
+
+	if table.Qualifies(5) {
+		t.Fatal("a score below a full table should not qualify")
+	}
+
+	table, rank := table.Insert(Entry{Initials: "NEW", Score: 55})
+	if rank != 5 {
+		t.Fatalf("rank = %d, want 5", rank)
+	}
+	if len(table) != MaxEntries {
+		t.Fatalf("len = %d, want %d", len(table), MaxEntries)
+	}
+	if table[len(table)-1].Score != 20 {
+		t.Fatalf("lowest score = %d, want 20 after 10 fell off", table[len(table)-1].Score)
+	}
+
+	/* a tie ranks below the existing score */
+	if _, rank := table.Insert(Entry{Score: 100}); rank != 1 {
+		t.Fatalf("tie rank = %d, want 1", rank)
+	}
+}
@@ -0,0 +1,89 @@
+// Package hitbox traces collision outlines from sprite images.
+package hitbox
+
+import (
+	"image"
+	"slices"
+)
+
+// AlphaThreshold is the alpha above which a pixel counts as solid; soft
+// glows and anti-aliased edges below it are left out of the outline.
+const AlphaThreshold = 0x40
+
+// Hull returns the convex hull of img's solid pixels, clockwise on
+// screen, relative to the image's top-left corner. An image with no solid
+// pixels gives its bounds.
+func Hull(img image.Image) []image.Point {
+	b := img.Bounds()
+
+	/* only the outermost solid pixel at each end of a row can be on the hull */
+	var points []image.Point
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		left, right := -1, -1
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a>>8 > AlphaThreshold {
+				if left < 0 {
+					left = x
+				}
+				right = x
+			}
+		}
+
+		if left < 0 {
+			continue
+		}
+
+		/* use pixel corners so a single solid pixel still has area */
+		points = append(points,
+			image.Pt(left, y), image.Pt(left, y+1),
+			image.Pt(right+1, y), image.Pt(right+1, y+1),
+		)
+	}
+
+	hull := convexHull(points)
+	if len(hull) < 3 {
+		hull = []image.Point{b.Min, {b.Max.X, b.Min.Y}, b.Max, {b.Min.X, b.Max.Y}}
+	}
+
+	for i := range hull {
+		hull[i] = hull[i].Sub(b.Min)
+	}
+
+	return hull
+}
+
+/* convexHull is Andrew's monotone chain, dropping collinear points */
+func convexHull(points []image.Point) []image.Point {
+	if len(points) < 3 {
+		return points
+	}
+
+	slices.SortFunc(points, func(a, b image.Point) int {
+		if a.X != b.X {
+			return a.X - b.X
+		}
+		return a.Y - b.Y
+	})
+	points = slices.Compact(points)
+
+	hull := make([]image.Point, 0, 2*len(points))
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range points {
+			for len(hull) >= start+2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+
+		/* the last point of each chain starts the other */
+		hull = hull[:len(hull)-1]
+		slices.Reverse(points)
+	}
+
+	return hull
+}
+
+func cross(o, a, b image.Point) int {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
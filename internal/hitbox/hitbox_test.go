@@ -0,0 +1,76 @@
+package hitbox
+
+import (
+	"image"
+	"image/color"
+	"slices"
+	"testing"
+)
+
+func TestHullOfRectangle(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	for y := 2; y < 5; y++ {
+		for x := 3; x < 9; x++ {
+			img.Set(x, y, color.White)
+		}
+	}
+
+	got := Hull(img)
+	want := []image.Point{{3, 2}, {3, 5}, {9, 5}, {9, 2}}
+	if !sameCorners(got, want) {
+		t.Errorf("Hull = %v; want corners %v", got, want)
+	}
+}
+
+func TestHullIgnoresFaintPixels(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	img.Set(0, 0, color.NRGBA{A: AlphaThreshold / 2})
+	for y := 4; y < 6; y++ {
+		for x := 4; x < 6; x++ {
+			img.Set(x, y, color.White)
+		}
+	}
+
+	want := []image.Point{{4, 4}, {4, 6}, {6, 6}, {6, 4}}
+	if got := Hull(img); !sameCorners(got, want) {
+		t.Errorf("Hull = %v; want corners %v", got, want)
+	}
+}
+
+func TestHullOfTriangleIsConvex(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 20, 20))
+	for y := 0; y < 20; y++ {
+		for x := 0; x <= y; x++ {
+			img.Set(x, y, color.White)
+		}
+	}
+
+	hull := Hull(img)
+	for i := range hull {
+		a, b, c := hull[i], hull[(i+1)%len(hull)], hull[(i+2)%len(hull)]
+		if cross(a, b, c) <= 0 {
+			t.Fatalf("hull %v turns the wrong way at %v", hull, b)
+		}
+	}
+}
+
+func TestHullOfEmptyImageIsBounds(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(5, 5, 15, 25))
+
+	want := []image.Point{{0, 0}, {10, 0}, {10, 20}, {0, 20}}
+	if got := Hull(img); !sameCorners(got, want) {
+		t.Errorf("Hull = %v; want %v", got, want)
+	}
+}
+
+func sameCorners(got, want []image.Point) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for _, p := range want {
+		if !slices.Contains(got, p) {
+			return false
+		}
+	}
+	return true
+}
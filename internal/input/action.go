@@ -0,0 +1,75 @@
+package input
+
+import "fmt"
+
+// Action is a logical control that scenes and entities query instead of raw keys.
+type Action int
+
+const (
+	Thrust Action = iota
+	Reverse
+	RotateLeft
+	RotateRight
+	Fire
+	Shield
+	Hyperspace
+	Pause
+	Confirm
+	Quit
+
+	actionCount
+)
+
+var actionNames = [actionCount]string{
+	Thrust:      "Thrust",
+	Reverse:     "Reverse",
+	RotateLeft:  "RotateLeft",
+	RotateRight: "RotateRight",
+	Fire:        "Fire",
+	Shield:      "Shield",
+	Hyperspace:  "Hyperspace",
+	Pause:       "Pause",
+	Confirm:     "Confirm",
+	Quit:        "Quit",
+}
+
+// Actions lists every action in declaration order.
+func Actions() []Action {
+	actions := make([]Action, actionCount)
+	for i := range actions {
+		actions[i] = Action(i)
+	}
+	return actions
+}
+
+func (a Action) String() string {
+	if a < 0 || a >= actionCount {
+		return fmt.Sprintf("Action(%d)", int(a))
+	}
+	return actionNames[a]
+}
+
+func (a Action) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Action) UnmarshalText(text []byte) error {
+	for i, name := range actionNames {
+		if name == string(text) {
+			*a = Action(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("input: unknown action %q", string(text))
+}
+
+// Snapshot is the set of actions held during a single tick.
+type Snapshot uint16
+
+func (s Snapshot) Has(a Action) bool {
+	return s&(1<<a) != 0
+}
+
+func (s *Snapshot) Set(a Action) {
+	*s |= 1 << a
+}
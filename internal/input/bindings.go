@@ -0,0 +1,82 @@
+package input
+
+import (
+	"encoding/json"
+	"errors"
+	"go-asteroids/internal/storage"
+	"io/fs"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const bindingsFile = "controls.json"
+
+// Bindings maps each action to the keys that trigger it.
+type Bindings map[Action][]ebiten.Key
+
+// DefaultBindings returns the stock keyboard layout.
+func DefaultBindings() Bindings {
+	return Bindings{
+		Thrust:      {ebiten.KeyArrowUp},
+		Reverse:     {ebiten.KeyArrowDown},
+		RotateLeft:  {ebiten.KeyArrowLeft},
+		RotateRight: {ebiten.KeyArrowRight},
+		Fire:        {ebiten.KeySpace},
+		Shield:      {ebiten.KeyS},
+		Hyperspace:  {ebiten.KeyH},
+		Pause:       {ebiten.KeyP, ebiten.KeyEscape},
+		Confirm:     {ebiten.KeySpace, ebiten.KeyEnter},
+		Quit:        {ebiten.KeyQ},
+	}
+}
+
+// BindingsPath is where user key bindings are read from.
+func BindingsPath() (string, error) {
+	return storage.Path(bindingsFile)
+}
+
+// Clone copies b so it can be edited without touching the bindings in use.
+func (b Bindings) Clone() Bindings {
+	clone := make(Bindings, len(b))
+	for action, keys := range b {
+		clone[action] = append([]ebiten.Key(nil), keys...)
+	}
+	return clone
+}
+
+// SaveBindings writes b to path in the format LoadBindings reads.
+func SaveBindings(path string, b Bindings) error {
+	contents, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return storage.WriteFile(path, contents)
+}
+
+// LoadBindings reads a JSON file of action names to key names, e.g.
+// {"Thrust": ["Z"], "RotateLeft": ["Q"]}. Actions missing from the file keep
+// their default keys. A missing file is not an error.
+func LoadBindings(path string) (Bindings, error) {
+	b := DefaultBindings()
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return b, nil
+	}
+	if err != nil {
+		return b, err
+	}
+
+	var overrides Bindings
+	if err := json.Unmarshal(contents, &overrides); err != nil {
+		return b, err
+	}
+
+	for action, keys := range overrides {
+		b[action] = keys
+	}
+
+	return b, nil
+}
@@ -0,0 +1,41 @@
+package input
+
+import "math"
+
+const (
+	maxTurn     = math.MaxInt8
+	maxThrottle = math.MaxUint8
+)
+
+// Frame is everything the simulation reads from Input in one tick. Analog
+// values are quantized so a recorded frame replays exactly as it was played.
+type Frame struct {
+	Actions  Snapshot
+	Turn     int8
+	Throttle uint8
+}
+
+// NewFrame builds a frame from digital actions alone.
+func NewFrame(s Snapshot) Frame {
+	f := Frame{Actions: s}
+
+	if s.Has(RotateLeft) {
+		f.Turn -= maxTurn
+	}
+	if s.Has(RotateRight) {
+		f.Turn += maxTurn
+	}
+	if s.Has(Thrust) {
+		f.Throttle = maxThrottle
+	}
+
+	return f
+}
+
+func quantizeTurn(v float64) int8 {
+	return int8(math.Round(math.Max(-1, math.Min(1, v)) * maxTurn))
+}
+
+func quantizeThrottle(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * maxThrottle))
+}
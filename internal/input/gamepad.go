@@ -0,0 +1,184 @@
+package input
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-asteroids/internal/storage"
+	"io/fs"
+	"math"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	gamepadsFile = "gamepads.json"
+
+	/* DefaultProfile is the gamepads.json entry used for devices without their own */
+	DefaultProfile = "default"
+
+	/* stick travel below this is treated as centred */
+	stickDeadZone = 0.25
+)
+
+// GamepadButton is a button in ebiten's standard gamepad layout.
+type GamepadButton ebiten.StandardGamepadButton
+
+var gamepadButtonNames = map[GamepadButton]string{
+	GamepadButton(ebiten.StandardGamepadButtonRightBottom):      "RightBottom",
+	GamepadButton(ebiten.StandardGamepadButtonRightRight):       "RightRight",
+	GamepadButton(ebiten.StandardGamepadButtonRightLeft):        "RightLeft",
+	GamepadButton(ebiten.StandardGamepadButtonRightTop):         "RightTop",
+	GamepadButton(ebiten.StandardGamepadButtonFrontTopLeft):     "FrontTopLeft",
+	GamepadButton(ebiten.StandardGamepadButtonFrontTopRight):    "FrontTopRight",
+	GamepadButton(ebiten.StandardGamepadButtonFrontBottomLeft):  "FrontBottomLeft",
+	GamepadButton(ebiten.StandardGamepadButtonFrontBottomRight): "FrontBottomRight",
+	GamepadButton(ebiten.StandardGamepadButtonCenterLeft):       "CenterLeft",
+	GamepadButton(ebiten.StandardGamepadButtonCenterRight):      "CenterRight",
+	GamepadButton(ebiten.StandardGamepadButtonLeftStick):        "LeftStick",
+	GamepadButton(ebiten.StandardGamepadButtonRightStick):       "RightStick",
+	GamepadButton(ebiten.StandardGamepadButtonLeftTop):          "LeftTop",
+	GamepadButton(ebiten.StandardGamepadButtonLeftBottom):       "LeftBottom",
+	GamepadButton(ebiten.StandardGamepadButtonLeftLeft):         "LeftLeft",
+	GamepadButton(ebiten.StandardGamepadButtonLeftRight):        "LeftRight",
+	GamepadButton(ebiten.StandardGamepadButtonCenterCenter):     "CenterCenter",
+}
+
+func (b GamepadButton) String() string {
+	if name, ok := gamepadButtonNames[b]; ok {
+		return name
+	}
+	return fmt.Sprintf("GamepadButton(%d)", int(b))
+}
+
+func (b GamepadButton) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *GamepadButton) UnmarshalText(text []byte) error {
+	for button, name := range gamepadButtonNames {
+		if name == string(text) {
+			*b = button
+			return nil
+		}
+	}
+	return fmt.Errorf("input: unknown gamepad button %q", string(text))
+}
+
+// GamepadBindings maps each action to the standard-layout buttons that trigger it.
+type GamepadBindings map[Action][]GamepadButton
+
+// GamepadProfiles holds per-device bindings keyed by SDL GUID, plus DefaultProfile.
+type GamepadProfiles map[string]GamepadBindings
+
+// DefaultGamepadBindings returns the stock pad layout: triggers fire, face
+// buttons shield and hyperspace, d-pad mirrors the left stick.
+func DefaultGamepadBindings() GamepadBindings {
+	return GamepadBindings{
+		Thrust:      {GamepadButton(ebiten.StandardGamepadButtonLeftTop)},
+		Reverse:     {GamepadButton(ebiten.StandardGamepadButtonLeftBottom)},
+		RotateLeft:  {GamepadButton(ebiten.StandardGamepadButtonLeftLeft)},
+		RotateRight: {GamepadButton(ebiten.StandardGamepadButtonLeftRight)},
+		Fire: {
+			GamepadButton(ebiten.StandardGamepadButtonFrontBottomRight),
+			GamepadButton(ebiten.StandardGamepadButtonFrontTopRight),
+		},
+		Shield:     {GamepadButton(ebiten.StandardGamepadButtonRightLeft)},
+		Hyperspace: {GamepadButton(ebiten.StandardGamepadButtonRightTop)},
+		Pause:      {GamepadButton(ebiten.StandardGamepadButtonCenterRight)},
+		Confirm:    {GamepadButton(ebiten.StandardGamepadButtonRightBottom)},
+		Quit:       {GamepadButton(ebiten.StandardGamepadButtonCenterLeft)},
+	}
+}
+
+// For resolves the bindings for a device: its own profile if present, else
+// the default profile, with any unbound actions falling back to the stock pad layout.
+func (p GamepadProfiles) For(sdlID string) GamepadBindings {
+	b := DefaultGamepadBindings()
+
+	profile, ok := p[sdlID]
+	if !ok {
+		profile = p[DefaultProfile]
+	}
+
+	for action, buttons := range profile {
+		b[action] = buttons
+	}
+
+	return b
+}
+
+// GamepadProfilesPath is where per-device gamepad bindings are read from.
+func GamepadProfilesPath() (string, error) {
+	return storage.Path(gamepadsFile)
+}
+
+// LoadGamepadProfiles reads a JSON file of device GUIDs to action bindings, e.g.
+// {"default": {"Fire": ["RightBottom"]}}. A missing file is not an error.
+func LoadGamepadProfiles(path string) (GamepadProfiles, error) {
+	profiles := GamepadProfiles{}
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return profiles, nil
+	}
+	if err != nil {
+		return profiles, err
+	}
+
+	if err := json.Unmarshal(contents, &profiles); err != nil {
+		return GamepadProfiles{}, err
+	}
+
+	return profiles, nil
+}
+
+/* gamepad is a connected device and the bindings resolved for it */
+type gamepad struct {
+	id       ebiten.GamepadID
+	bindings GamepadBindings
+}
+
+func (g *gamepad) poll(s *Snapshot) (turn, throttle float64) {
+	if !ebiten.IsStandardGamepadLayoutAvailable(g.id) {
+		return 0, 0
+	}
+
+	for action, buttons := range g.bindings {
+		for _, b := range buttons {
+			if ebiten.IsStandardGamepadButtonPressed(g.id, ebiten.StandardGamepadButton(b)) {
+				s.Set(action)
+				break
+			}
+		}
+	}
+
+	/* left stick: horizontal turns, pushing up thrusts and down reverses */
+	x := deadZone(ebiten.StandardGamepadAxisValue(g.id, ebiten.StandardGamepadAxisLeftStickHorizontal))
+	y := deadZone(ebiten.StandardGamepadAxisValue(g.id, ebiten.StandardGamepadAxisLeftStickVertical))
+
+	switch {
+	case x < 0:
+		s.Set(RotateLeft)
+	case x > 0:
+		s.Set(RotateRight)
+	}
+
+	switch {
+	case y < 0:
+		s.Set(Thrust)
+	case y > 0:
+		s.Set(Reverse)
+	}
+
+	return x, math.Max(-y, 0)
+}
+
+/* deadZone zeroes small stick travel and rescales the rest to the full range */
+func deadZone(v float64) float64 {
+	if math.Abs(v) < stickDeadZone {
+		return 0
+	}
+	return math.Copysign((math.Abs(v)-stickDeadZone)/(1-stickDeadZone), v)
+}
@@ -0,0 +1,51 @@
+package input
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestGamepadProfilesFor(t *testing.T) {
+	var profiles GamepadProfiles
+	err := json.Unmarshal([]byte(`{
+		"default": {"Fire": ["RightBottom"]},
+		"030000005e0400008e02000014010000": {"Shield": ["FrontTopLeft"]}
+	}`), &profiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fallback := profiles.For("unknown-device")
+	if !slices.Equal(fallback[Fire], []GamepadButton{GamepadButton(ebiten.StandardGamepadButtonRightBottom)}) {
+		t.Fatalf("default profile Fire = %v, want [RightBottom]", fallback[Fire])
+	}
+
+	device := profiles.For("030000005e0400008e02000014010000")
+	if !slices.Equal(device[Shield], []GamepadButton{GamepadButton(ebiten.StandardGamepadButtonFrontTopLeft)}) {
+		t.Fatalf("device profile Shield = %v, want [FrontTopLeft]", device[Shield])
+	}
+	if !slices.Equal(device[Fire], DefaultGamepadBindings()[Fire]) {
+		t.Fatalf("device profile Fire = %v, want the stock binding", device[Fire])
+	}
+}
+
+func TestDeadZone(t *testing.T) {
+	tests := []struct {
+		in, want float64
+	}{
+		{0.1, 0},
+		{-0.2, 0},
+		{1, 1},
+		{-1, -1},
+		{0.625, 0.5},
+	}
+
+	for _, tt := range tests {
+		if got := deadZone(tt.in); got != tt.want {
+			t.Errorf("deadZone(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
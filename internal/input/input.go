@@ -0,0 +1,159 @@
+package input
+
+import (
+	"log"
+	"maps"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Input samples the bound keys and gamepads once per tick and answers action queries.
+type Input struct {
+	bindings Bindings
+	profiles GamepadProfiles
+	gamepads map[ebiten.GamepadID]*gamepad
+	current  Frame
+	previous Frame
+	chars    []rune
+	keys     []ebiten.Key
+
+	/* text and raw presses gathered by Collect since the last Update */
+	pendingChars []rune
+	pendingKeys  []ebiten.Key
+
+	connected []ebiten.GamepadID
+}
+
+func New(b Bindings, profiles GamepadProfiles) *Input {
+	return &Input{
+		bindings: b,
+		profiles: profiles,
+		gamepads: make(map[ebiten.GamepadID]*gamepad),
+	}
+}
+
+// Collect gathers typed text, raw key presses and gamepad connections. Call
+// it every ebiten tick, including those that run no simulation step, since
+// ebiten reports them for one tick only.
+func (i *Input) Collect() {
+	i.detectGamepads()
+	i.pendingChars = ebiten.AppendInputChars(i.pendingChars)
+	i.pendingKeys = inpututil.AppendJustPressedKeys(i.pendingKeys)
+}
+
+// Update polls the controls for one simulation step and hands it the text
+// and keys collected since the last one; call it once per step before any
+// queries.
+func (i *Input) Update() {
+	i.chars = append(i.chars[:0], i.pendingChars...)
+	i.keys = append(i.keys[:0], i.pendingKeys...)
+	i.pendingChars = i.pendingChars[:0]
+	i.pendingKeys = i.pendingKeys[:0]
+
+	var s Snapshot
+	for action, keys := range i.bindings {
+		for _, k := range keys {
+			if ebiten.IsKeyPressed(k) {
+				s.Set(action)
+				break
+			}
+		}
+	}
+
+	/* poll in ID order so, when several pads move a stick, the same one wins every tick */
+	var turn, throttle float64
+	for _, id := range slices.Sorted(maps.Keys(i.gamepads)) {
+		t, th := i.gamepads[id].poll(&s)
+		if t != 0 {
+			turn = t
+		}
+		if th != 0 {
+			throttle = th
+		}
+	}
+
+	/* analog sticks win over the digital fallback */
+	f := NewFrame(s)
+	if turn != 0 {
+		f.Turn = quantizeTurn(turn)
+	}
+	if throttle != 0 {
+		f.Throttle = quantizeThrottle(throttle)
+	}
+
+	i.SetFrame(f)
+}
+
+/* detectGamepads handles hot-plugging, resolving a binding profile per device */
+func (i *Input) detectGamepads() {
+	i.connected = inpututil.AppendJustConnectedGamepadIDs(i.connected[:0])
+	for _, id := range i.connected {
+		sdlID := ebiten.GamepadSDLID(id)
+		i.gamepads[id] = &gamepad{id: id, bindings: i.profiles.For(sdlID)}
+		log.Printf("Gamepad connected: %s (%s)", ebiten.GamepadName(id), sdlID)
+	}
+
+	for id := range i.gamepads {
+		if inpututil.IsGamepadJustDisconnected(id) {
+			delete(i.gamepads, id)
+			log.Printf("Gamepad disconnected: %d", id)
+		}
+	}
+}
+
+// Set replaces the current tick's action state as if every control were a key.
+func (i *Input) Set(s Snapshot) {
+	i.SetFrame(NewFrame(s))
+}
+
+// SetFrame replaces the current tick's state; replays feed recorded frames through here.
+func (i *Input) SetFrame(f Frame) {
+	i.previous = i.current
+	i.current = f
+}
+
+func (i *Input) Frame() Frame {
+	return i.current
+}
+
+func (i *Input) Bindings() Bindings {
+	return i.bindings
+}
+
+func (i *Input) SetBindings(b Bindings) {
+	i.bindings = b
+}
+
+func (i *Input) IsPressed(a Action) bool {
+	return i.current.Actions.Has(a)
+}
+
+func (i *Input) IsJustPressed(a Action) bool {
+	return i.current.Actions.Has(a) && !i.previous.Actions.Has(a)
+}
+
+func (i *Input) IsJustReleased(a Action) bool {
+	return !i.current.Actions.Has(a) && i.previous.Actions.Has(a)
+}
+
+// Chars is the text typed this tick, for name entry rather than gameplay.
+func (i *Input) Chars() []rune {
+	return i.chars
+}
+
+// JustPressedKeys are the raw keys pressed this tick, for capturing new bindings.
+func (i *Input) JustPressedKeys() []ebiten.Key {
+	return i.keys
+}
+
+// Turn is the rotation input from -1 (full left) to 1 (full right).
+func (i *Input) Turn() float64 {
+	return float64(i.current.Turn) / maxTurn
+}
+
+// Throttle is the thrust input from 0 to 1.
+func (i *Input) Throttle() float64 {
+	return float64(i.current.Throttle) / maxThrottle
+}
@@ -0,0 +1,64 @@
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestInputEdges(t *testing.T) {
+	in := New(DefaultBindings(), GamepadProfiles{})
+
+	var held Snapshot
+	held.Set(Fire)
+
+	in.Set(held)
+	if !in.IsPressed(Fire) || !in.IsJustPressed(Fire) {
+		t.Fatal("fire should be pressed and just pressed on the first tick")
+	}
+
+	in.Set(held)
+	if in.IsJustPressed(Fire) {
+		t.Fatal("fire should not be just pressed while held")
+	}
+
+	in.Set(0)
+	if in.IsPressed(Fire) || !in.IsJustReleased(Fire) {
+		t.Fatal("fire should be just released after letting go")
+	}
+}
+
+func TestLoadBindingsOverridesDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), bindingsFile)
+	if err := os.WriteFile(path, []byte(`{"Thrust": ["W"], "RotateLeft": ["A", "ArrowLeft"]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := LoadBindings(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(b[Thrust], []ebiten.Key{ebiten.KeyW}) {
+		t.Fatalf("Thrust = %v, want [W]", b[Thrust])
+	}
+	if !slices.Equal(b[RotateLeft], []ebiten.Key{ebiten.KeyA, ebiten.KeyArrowLeft}) {
+		t.Fatalf("RotateLeft = %v, want [A ArrowLeft]", b[RotateLeft])
+	}
+	if !slices.Equal(b[Fire], DefaultBindings()[Fire]) {
+		t.Fatalf("Fire = %v, want the default", b[Fire])
+	}
+}
+
+func TestLoadBindingsMissingFile(t *testing.T) {
+	b, err := LoadBindings(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != int(actionCount) {
+		t.Fatalf("got %d bindings, want defaults for all %d actions", len(b), actionCount)
+	}
+}
@@ -0,0 +1,198 @@
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"go-asteroids/internal/input"
+	"go-asteroids/internal/settings"
+	"go-asteroids/internal/storage"
+	"io"
+	"os"
+	"time"
+)
+
+// Version is bumped whenever the file layout or the simulation changes;
+// Decode refuses any other version rather than play it back differently.
+const Version = 11
+
+const fileExt = ".replay"
+
+var magic = [4]byte{'G', 'A', 'R', 'P'}
+
+// Replay is the seed and per-tick input of one GameScene run, plus the result
+// it produced so playback can be verified.
+type Replay struct {
+	Seed       int64
+	Difficulty settings.Difficulty
+	Movement   settings.Movement
+	WrapLasers bool
+	Score      int
+	Level      int
+	Frames     []input.Frame
+}
+
+// Save writes r to a new timestamped file in the replays folder of the
+// game's storage directory and returns its path.
+func Save(r *Replay) (string, error) {
+	name := fmt.Sprintf("%s-%d%s", time.Now().Format("20060102-150405"), r.Seed, fileExt)
+	path, err := storage.Path("replays", name)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, r); err != nil {
+		return "", err
+	}
+
+	return path, storage.WriteFile(path, buf.Bytes())
+}
+
+func Load(path string) (*Replay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Decode(f)
+}
+
+// Encode writes r as a header followed by run-length encoded frames, since a
+// player holds the same controls for many ticks in a row.
+func Encode(w io.Writer, r *Replay) error {
+	bw := bufio.NewWriter(w)
+
+	var wrap uint64
+	if r.WrapLasers {
+		wrap = 1
+	}
+
+	var buf []byte
+	buf = append(buf, magic[:]...)
+	buf = binary.AppendUvarint(buf, Version)
+	buf = binary.AppendUvarint(buf, uint64(r.Difficulty))
+	buf = binary.AppendUvarint(buf, uint64(r.Movement))
+	buf = binary.AppendUvarint(buf, wrap)
+	buf = binary.AppendVarint(buf, r.Seed)
+	buf = binary.AppendUvarint(buf, uint64(r.Score))
+	buf = binary.AppendUvarint(buf, uint64(r.Level))
+	buf = binary.AppendUvarint(buf, uint64(len(r.Frames)))
+
+	for i := 0; i < len(r.Frames); {
+		f := r.Frames[i]
+		run := 1
+		for i+run < len(r.Frames) && r.Frames[i+run] == f {
+			run++
+		}
+
+		buf = binary.AppendUvarint(buf, uint64(run))
+		buf = binary.AppendUvarint(buf, uint64(f.Actions))
+		buf = append(buf, byte(f.Turn), f.Throttle)
+
+		i += run
+	}
+
+	if _, err := bw.Write(buf); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+func Decode(rd io.Reader) (*Replay, error) {
+	br := bufio.NewReader(rd)
+
+	var m [4]byte
+	if _, err := io.ReadFull(br, m[:]); err != nil {
+		return nil, err
+	}
+	if m != magic {
+		return nil, errors.New("replay: not a replay file")
+	}
+
+	version, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	if version < Version {
+		return nil, fmt.Errorf("replay: recorded with an older build (version %d, this build plays %d)", version, Version)
+	}
+	if version > Version {
+		return nil, fmt.Errorf("replay: recorded with a newer build (version %d, this build plays %d)", version, Version)
+	}
+
+	difficulty, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	movement, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	wrap, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Replay{
+		Difficulty: settings.Difficulty(difficulty),
+		Movement:   settings.Movement(movement),
+		WrapLasers: wrap != 0,
+	}
+	if !r.Difficulty.Valid() || !r.Movement.Valid() {
+		return nil, errors.New("replay: corrupt header")
+	}
+	if r.Seed, err = binary.ReadVarint(br); err != nil {
+		return nil, err
+	}
+
+	score, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	level, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	count, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	r.Score = int(score)
+	r.Level = int(level)
+
+	for uint64(len(r.Frames)) < count {
+		run, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		actions, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+
+		var analog [2]byte
+		if _, err := io.ReadFull(br, analog[:]); err != nil {
+			return nil, err
+		}
+
+		if run == 0 || uint64(len(r.Frames))+run > count {
+			return nil, errors.New("replay: corrupt frame data")
+		}
+
+		f := input.Frame{
+			Actions:  input.Snapshot(actions),
+			Turn:     int8(analog[0]),
+			Throttle: analog[1],
+		}
+		for range run {
+			r.Frames = append(r.Frames, f)
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,92 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/binary"
+	"go-asteroids/internal/input"
+	"go-asteroids/internal/settings"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	var fire input.Snapshot
+	fire.Set(input.Fire)
+
+	want := &Replay{
+		Seed:       -42,
+		Difficulty: settings.Hard,
+		Movement:   settings.Newtonian,
+		WrapLasers: true,
+		Score:      117,
+		Level:      3,
+		Frames: []input.Frame{
+			{},
+			{},
+			{Actions: fire},
+			{Actions: fire, Turn: -64, Throttle: 200},
+			{Actions: fire, Turn: -64, Throttle: 200},
+			{},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Decode() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDecodeRejectsOtherFiles(t *testing.T) {
+	if _, err := Decode(bytes.NewReader([]byte("0123456789"))); err == nil {
+		t.Fatal("expected an error decoding a non-replay file")
+	}
+}
+
+func TestDecodeRejectsOtherVersions(t *testing.T) {
+	tests := []struct {
+		version uint64
+		want    string
+	}{
+		{Version - 1, "older build"},
+		{Version + 1, "newer build"},
+	}
+
+	for _, tt := range tests {
+		file := binary.AppendUvarint([]byte("GARP"), tt.version)
+
+		_, err := Decode(bytes.NewReader(file))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Decode(version %d) error = %v, want one saying %q", tt.version, err, tt.want)
+		}
+	}
+}
+
+func TestDecodeRejectsUnknownModes(t *testing.T) {
+	tests := []struct {
+		difficulty, movement byte
+	}{
+		{byte(settings.Hard) + 1, byte(settings.Arcade)},
+		{byte(settings.Normal), byte(settings.Arcade) + 1},
+	}
+
+	for _, tt := range tests {
+		file := binary.AppendUvarint([]byte("GARP"), Version)
+		file = append(file, tt.difficulty, tt.movement, 0)
+		file = binary.AppendVarint(file, 42)
+		file = append(file, 10, 1, 0) // score, level, no frames
+
+		if _, err := Decode(bytes.NewReader(file)); err == nil {
+			t.Errorf("Decode(difficulty %d, movement %d) accepted modes the game does not have", tt.difficulty, tt.movement)
+		}
+	}
+}
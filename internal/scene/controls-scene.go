@@ -0,0 +1,128 @@
+package scene
+
+import (
+	"fmt"
+	"go-asteroids/assets"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/entity"
+	"go-asteroids/internal/input"
+	"image/color"
+	"log"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+const (
+	menuResetControls = "RESET TO DEFAULTS"
+	menuBack          = "BACK"
+)
+
+/* ControlsScene lists each action's keys; confirming an action waits for the next key press and binds it */
+type ControlsScene struct {
+	stars     []*entity.Star
+	bindings  input.Bindings
+	menu      *menu
+	listening bool
+}
+
+/* the scene edits a copy of in's bindings so nothing changes in play until they are saved */
+func newControlsScene(in *Input, stars []*entity.Star) *ControlsScene {
+	c := &ControlsScene{
+		stars:    stars,
+		bindings: in.Bindings().Clone(),
+		menu:     newMenu(make([]string, len(input.Actions())+2)...),
+	}
+	c.refresh()
+
+	return c
+}
+
+func (c *ControlsScene) BlocksUpdate() bool { return true }
+func (c *ControlsScene) BlocksDraw() bool   { return true }
+
+func (c *ControlsScene) Draw(screen *ebiten.Image, _ float64) {
+	for _, s := range c.stars {
+		s.Draw(screen)
+	}
+
+	drawText(screen, "Controls", assets.TitleFont, 48, engine.ScreenWidth/2, 60, text.AlignCenter, color.White)
+	c.menu.draw(screen, 140)
+
+	hint := "CONFIRM TO REBIND"
+	if c.listening {
+		hint = "PRESS A KEY, OR ESCAPE TO CANCEL"
+	}
+	drawText(screen, hint, assets.ScoreFont, 16, engine.ScreenWidth/2, engine.ScreenHeight-40, text.AlignCenter, dimmed)
+}
+
+func (c *ControlsScene) Update(state *State) error {
+	actions := input.Actions()
+
+	if c.listening {
+		for _, k := range state.Input.JustPressedKeys() {
+			if k != ebiten.KeyEscape {
+				c.bindings[actions[c.menu.selected]] = []ebiten.Key{k}
+			}
+			c.listening = false
+			c.refresh()
+			break
+		}
+		return nil
+	}
+
+	if state.Input.IsJustPressed(input.Pause) {
+		c.close(state)
+		return nil
+	}
+
+	switch item, _ := c.menu.update(state); item {
+	case "":
+	case menuResetControls:
+		c.bindings = input.DefaultBindings()
+		c.refresh()
+	case menuBack:
+		c.close(state)
+	default:
+		c.listening = true
+		c.refresh()
+	}
+
+	return nil
+}
+
+/* refresh rewrites the menu labels with the current keys */
+func (c *ControlsScene) refresh() {
+	for i, a := range input.Actions() {
+		keys := "?"
+		if c.listening && i == c.menu.selected {
+			keys = "..."
+		} else if len(c.bindings[a]) > 0 {
+			names := make([]string, len(c.bindings[a]))
+			for j, k := range c.bindings[a] {
+				names[j] = strings.ToUpper(k.String())
+			}
+			keys = strings.Join(names, " / ")
+		}
+
+		c.menu.items[i] = fmt.Sprintf("%s  %s", strings.ToUpper(a.String()), keys)
+	}
+
+	c.menu.items[len(c.menu.items)-2] = menuResetControls
+	c.menu.items[len(c.menu.items)-1] = menuBack
+}
+
+func (c *ControlsScene) close(state *State) {
+	state.Input.SetBindings(c.bindings)
+
+	path, err := input.BindingsPath()
+	if err == nil {
+		err = input.SaveBindings(path, c.bindings)
+	}
+	if err != nil {
+		log.Println("Error saving key bindings", err)
+	}
+
+	state.SceneManager.PopScene()
+}
@@ -0,0 +1,26 @@
+package scene
+
+import (
+	"go-asteroids/internal/entity"
+	"go-asteroids/internal/settings"
+	"time"
+)
+
+/* tuning holds the gameplay numbers that vary with difficulty; velocities are in pixels per second */
+type tuning struct {
+	meteorVelocity  float64
+	alienAttackTime time.Duration
+	extraLifeScore  int
+}
+
+var tunings = map[settings.Difficulty]tuning{
+	settings.Easy:   {meteorVelocity: 9, alienAttackTime: 4 * time.Second, extraLifeScore: 7500},
+	settings.Normal: {meteorVelocity: 15, alienAttackTime: 3 * time.Second, extraLifeScore: 10000},
+	settings.Hard:   {meteorVelocity: 24, alienAttackTime: 2 * time.Second, extraLifeScore: 15000},
+}
+
+/* handlings maps each movement profile to how the ship flies */
+var handlings = map[settings.Movement]entity.Handling{
+	settings.Newtonian: entity.NewtonianHandling,
+	settings.Arcade:    entity.ArcadeHandling,
+}
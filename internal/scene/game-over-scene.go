@@ -1,14 +1,16 @@
 package scene
 
 import (
+	"fmt"
 	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
 	"go-asteroids/internal/entity"
+	"go-asteroids/internal/input"
 	"image/color"
+	"math/rand"
 	"os"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 )
 
@@ -17,9 +19,11 @@ type GameOverScene struct {
 	meteors     map[int]*entity.Meteor
 	meteorCount int
 	stars       []*entity.Star
+	rng         *rand.Rand
+	entered     bool
 }
 
-func (o *GameOverScene) Draw(screen *ebiten.Image) {
+func (o *GameOverScene) Draw(screen *ebiten.Image, alpha float64) {
 	/* draw stars */
 	for _, s := range o.stars {
 		s.Draw(screen)
@@ -27,7 +31,7 @@ func (o *GameOverScene) Draw(screen *ebiten.Image) {
 
 	/* draw meteors */
 	for _, m := range o.meteors {
-		m.Draw(screen)
+		m.Draw(screen, alpha)
 	}
 
 	textToDraw := "Game Over"
@@ -44,6 +48,21 @@ func (o *GameOverScene) Draw(screen *ebiten.Image) {
 		Size:   48,
 	}, op)
 
+	/* draw the seed so testers can reproduce the run */
+	textToDraw = fmt.Sprintf("SEED %d", o.game.seed)
+	op = &text.DrawOptions{
+		LayoutOptions: text.LayoutOptions{
+			PrimaryAlign: text.AlignCenter,
+		},
+	}
+
+	op.ColorScale.ScaleWithColor(color.White)
+	op.GeoM.Translate(engine.ScreenWidth/2, engine.ScreenHeight-40)
+	text.Draw(screen, textToDraw, &text.GoTextFace{
+		Source: assets.ScoreFont,
+		Size:   16,
+	}, op)
+
 	if o.game.score > o.game.originalHighScore {
 		textToDraw = "New High Score!"
 		op := &text.DrawOptions{
@@ -61,29 +80,41 @@ func (o *GameOverScene) Draw(screen *ebiten.Image) {
 		}, op)
 
 	}
+
+	if o.game.madeLeaderboard && !o.entered {
+		drawText(screen, "PRESS CONFIRM TO ENTER YOUR INITIALS", assets.ScoreFont, 16,
+			engine.ScreenWidth/2, engine.ScreenHeight/2+180, text.AlignCenter, color.White)
+	}
 }
 
 func (o *GameOverScene) Update(state *State) error {
 	/* spawn meteors */
 	if len(o.meteors) < 10 {
-		m := entity.NewMeteor(0.25, len(o.meteors)-1)
+		m := entity.NewMeteor(o.rng, titleMeteorVelocity)
 		o.meteorCount++
 		o.meteors[o.meteorCount] = m
 	}
 
 	/* update meteors */
 	for _, m := range o.meteors {
-		m.Update()
+		m.Update(engine.Dt)
+	}
+
+	/* check to see if confirm pressed */
+	if state.Input.IsJustPressed(input.Confirm) && o.game.madeLeaderboard && !o.entered {
+		state.Sound.Play(soundMenuSelect)
+		state.SceneManager.PushScene(newInitialsScene(o))
+		return nil
 	}
 
-	/* check to see if spacebar pressed */
-	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-		o.game.Reset()
-		state.SceneManager.GoToScene(o.game)
+	if state.Input.IsJustPressed(input.Confirm) {
+		state.Sound.Play(soundMenuSelect)
+		o.game.restart()
+		state.SceneManager.GoToScene(o.game, CrossFade(25))
 	}
 
-	/* check to see if q pressed */
-	if inpututil.IsKeyJustPressed(ebiten.KeyQ) {
+	/* check to see if quit pressed */
+	if state.Input.IsJustPressed(input.Quit) {
 		os.Exit(0)
 	}
 
@@ -0,0 +1,66 @@
+package scene
+
+import (
+	"go-asteroids/internal/entity"
+	"time"
+)
+
+const (
+	slowestBeat = 1600 * time.Millisecond
+	fastestBeat = 400 * time.Millisecond
+
+	/* how much sooner the next beat comes while aliens are on screen, or on the last life */
+	alienBeatRush    = 250 * time.Millisecond
+	lastLifeBeatRush = 150 * time.Millisecond
+)
+
+/* beatSound alternates the two heartbeat notes, picking the gap to the next one from the state of play */
+func (g *GameScene) beatSound() {
+	if g.beatTimer.Update() == 0 {
+		return
+	}
+
+	if g.playBeatOne {
+		g.sound.Restart(soundBeatOne)
+	} else {
+		g.sound.Restart(soundBeatTwo)
+	}
+	g.playBeatOne = !g.playBeatOne
+
+	/* the timer repeats, so the next beat is timed from this one */
+	g.beatTimer.SetDuration(g.beatInterval())
+}
+
+/* beatInterval quickens as the wave is cleared, and again when aliens appear or the player is down to one life */
+func (g *GameScene) beatInterval() time.Duration {
+	interval := slowestBeat - time.Duration(float64(slowestBeat-fastestBeat)*g.waveCleared())
+
+	if entity.Count[*entity.Alien](g.entities) > 0 {
+		interval -= alienBeatRush
+	}
+
+	if g.player.LivesRemaining == 1 {
+		interval -= lastLifeBeatRush
+	}
+
+	return max(interval, fastestBeat)
+}
+
+/* waveCleared is how much of the level's meteors are gone, from 0 to 1; broken fragments still count as remaining */
+func (g *GameScene) waveCleared() float64 {
+	if g.meteorsPerLevel == 0 {
+		return 0
+	}
+
+	unspawned := max(g.meteorsPerLevel-g.meteorCount, 0)
+	remaining := float64(unspawned + entity.Count[*entity.Meteor](g.entities))
+
+	return 1 - min(remaining/float64(g.meteorsPerLevel), 1)
+}
+
+/* resetBeat starts the heartbeat over at its slowest, on death and at each new level */
+func (g *GameScene) resetBeat() {
+	g.playBeatOne = true
+	g.beatTimer.SetDuration(slowestBeat)
+	g.beatTimer.Reset()
+}
@@ -0,0 +1,35 @@
+package scene
+
+import (
+	"go-asteroids/internal/entity"
+	"testing"
+)
+
+func TestBeatQuickensWithPlay(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+	g.meteorsPerLevel = 4
+	g.meteorCount = 0
+
+	calm := g.beatInterval()
+	if calm != slowestBeat {
+		t.Fatalf("fresh wave beat = %v; want %v", calm, slowestBeat)
+	}
+
+	/* half the wave spawned and destroyed */
+	g.meteorCount = 2
+	cleared := g.beatInterval()
+	if cleared >= calm {
+		t.Errorf("half cleared beat = %v; want faster than %v", cleared, calm)
+	}
+
+	g.entities.Add(entity.NewAlien(g.rng, 0, g.player.Position))
+	if withAlien := g.beatInterval(); withAlien >= cleared {
+		t.Errorf("alien beat = %v; want faster than %v", withAlien, cleared)
+	}
+
+	g.meteorCount = 4
+	g.player.LivesRemaining = 1
+	if frantic := g.beatInterval(); frantic != fastestBeat {
+		t.Errorf("cleared wave on last life beat = %v; want %v", frantic, fastestBeat)
+	}
+}
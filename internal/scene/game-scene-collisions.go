@@ -3,120 +3,131 @@ package scene
 import (
 	"go-asteroids/internal/engine"
 	"go-asteroids/internal/entity"
-	"math/rand"
 )
 
 func (g *GameScene) isPlayerCollidingWithMeteor() {
-	for _, m := range g.meteors {
-		if m.Obj.IsIntersecting(g.player.PlayerObj) {
-			if !g.player.IsShielded {
-				/* trigger dying animation */
-				g.player.IsDying = true
-				/* play explosion sound */
-				playOnce(g.explosionPlayer)
-				break
-			} else {
-				/* bounce meteor if shielded */
-				g.bounceMeteor(m)
-			}
+	for _, obj := range engine.Touching(g.player.PlayerObj, engine.TagMeteor) {
+		m, ok := entity.Owner[*entity.Meteor](g.entities, obj)
+		if !ok {
+			continue
+		}
+
+		if !g.player.IsShielded {
+			/* trigger dying animation */
+			g.player.IsDying = true
+			/* play explosion sound */
+			g.sound.PlayAt(soundExplosion, g.soundAt(g.player.Position))
+			break
+		} else {
+			/* bounce meteor if shielded */
+			g.bounceMeteor(m)
 		}
 	}
 }
 
 func (g *GameScene) isPlayerCollidingWithAlien() {
-	for _, a := range g.aliens {
-		if a.Obj.IsIntersecting(g.player.PlayerObj) {
-			if !g.player.IsShielded {
-				/* trigger dying animation */
-				g.player.IsDying = true
-				/* play explosion sound */
-				playOnce(g.explosionPlayer)
-			}
-		}
+	if len(engine.Touching(g.player.PlayerObj, engine.TagAlien)) > 0 && !g.player.IsShielded {
+		/* trigger dying animation */
+		g.player.IsDying = true
+		/* play explosion sound */
+		g.sound.PlayAt(soundExplosion, g.soundAt(g.player.Position))
 	}
 }
 
 func (g *GameScene) isPlayerHitByAlienLaser() {
-	for _, al := range g.alienLasers {
-		if al.LaserObj.IsIntersecting(g.player.PlayerObj) {
-			if !g.player.IsShielded {
-				/* trigger dying animation */
-				g.player.IsDying = true
-				playOnce(g.explosionPlayer)
-			}
+	if g.shield != nil {
+		g.deflectAlienLasers()
+	}
+
+	for _, obj := range engine.Touching(g.player.PlayerObj, engine.TagAlienLaser) {
+		al, ok := entity.Owner[*entity.AlienLaser](g.entities, obj)
+		if !ok || al.Deflected {
+			continue
+		}
+
+		if !g.player.IsShielded {
+			/* trigger dying animation */
+			g.player.IsDying = true
+			g.sound.PlayAt(soundExplosion, g.soundAt(g.player.Position))
 		}
 	}
 }
 
-func (g *GameScene) isAlienHitByPlayerLaser() {
-	for _, a := range g.aliens {
-		for _, l := range g.lasers {
-			if a.Obj.IsIntersecting(l.Obj) {
-				laserData := l.Obj.Data().(*engine.ObjectData)
-				delete(g.alienLasers, laserData.Index)
-				g.space.Remove(l.Obj)
-				a.Sprite = g.explosionSprite
-				g.score = g.score + 50
-
-				/* play explosion sound*/
-				playOnce(g.explosionPlayer)
-			}
+/* deflectAlienLasers turns away enemy fire that reaches the shield */
+func (g *GameScene) deflectAlienLasers() {
+	for _, obj := range engine.Touching(g.shield.Obj, engine.TagAlienLaser) {
+		al, ok := entity.Owner[*entity.AlienLaser](g.entities, obj)
+		if !ok || al.Deflected {
+			continue
 		}
+
+		centre := g.player.PlayerObj.Position()
+		al.Deflect(engine.Vector{X: centre.X, Y: centre.Y})
+		g.sound.Play(soundShield)
 	}
 }
 
-func (g *GameScene) isMeteorHitByPlayerLaser() {
-	for _, m := range g.meteors {
-		for _, l := range g.lasers {
-			if m.Obj.IsIntersecting(l.Obj) {
-				if m.Obj.Tags().Has(engine.TagSmall) {
-					/* hit small meteor */
-					m.Sprite = g.explosionSmallSprite
-					g.score++
-
-					/* play explosion sound */
-					playOnce(g.explosionPlayer)
-				} else {
-					/* hit large meteor */
-					oldPos := m.Position
-					m.Sprite = g.explosionSprite
-					g.score++
-
-					/* play explosion sound */
-					playOnce(g.explosionPlayer)
-
-					numToSpawn := rand.Intn(numberOfSmallMeteorsFromLargeMeteor)
-					for range numToSpawn {
-						meteor := entity.NewSmallMeteor(baseMeteorVelocity, len(g.meteors)-1)
-						meteor.Position = engine.Vector{
-							X: oldPos.X + float64(rand.Intn(100-50)) + 50,
-							Y: oldPos.Y + float64(rand.Intn(100-50)) + 50,
-						}
-						meteor.Obj.SetPosition(meteor.Position.X, meteor.Position.Y)
-						g.space.Add(meteor.Obj)
-						g.meteorCount++
-						g.meteors[g.meteorCount] = meteor
-					}
-
-				}
+func (g *GameScene) isAlienHitByPlayerLaser() {
+	for _, a := range entity.All[*entity.Alien](g.entities) {
+		/* a destroyed alien lingers while it explodes; it only scores once */
+		if a.IsExploding() {
+			continue
+		}
+
+		for _, obj := range engine.Touching(a.Obj, engine.TagLaser) {
+			l, ok := entity.Owner[*entity.Laser](g.entities, obj)
+			if !ok {
+				continue
 			}
+			g.entities.Remove(l)
+			a.Explode()
+			g.award(alienTarget(a), a.Position)
+
+			/* play explosion sound*/
+			g.sound.PlayAt(soundExplosion, g.soundAt(a.Position))
+			break
 		}
 	}
 }
 
-func (g *GameScene) bounceMeteor(m *entity.Meteor) {
-	direction := engine.Vector{
-		X: (engine.ScreenWidth/2 - m.Position.X) * -1,
-		Y: (engine.ScreenHeight/2 - m.Position.Y) * -1,
-	}
+func (g *GameScene) isMeteorHitByPlayerLaser() {
+	/* the registry visits in the order meteors arrived, so splits draw from the seeded rng reproducibly */
+	for _, m := range entity.All[*entity.Meteor](g.entities) {
+		/* an exploding meteor stays in the space until it has played out but cannot be shot again */
+		if m.IsExploding() {
+			continue
+		}
+
+		hits := engine.Touching(m.Obj, engine.TagLaser)
+		if len(hits) == 0 {
+			continue
+		}
 
-	normalized := direction.Normalize()
-	velocity := g.baseVelocity
+		/* the first laser to reach the meteor is spent on it */
+		l, ok := entity.Owner[*entity.Laser](g.entities, hits[0])
+		if !ok {
+			continue
+		}
+		g.entities.Remove(l)
+
+		centre := m.Obj.Position()
+		g.award(meteorTarget(m.Size), engine.Vector{X: centre.X, Y: centre.Y})
+
+		m.Explode()
+
+		/* play explosion sound */
+		g.sound.PlayAt(soundExplosion, g.soundAt(m.Position))
 
-	movement := engine.Vector{
-		X: normalized.X * velocity,
-		Y: normalized.Y * velocity,
+		for _, child := range m.Split(g.rng, l.Direction()) {
+			g.meteorCount++
+			g.entities.Add(child)
+		}
 	}
+}
+
+func (g *GameScene) bounceMeteor(m *entity.Meteor) {
+	centre := engine.Vector{X: engine.ScreenWidth / 2, Y: engine.ScreenHeight / 2}
 
-	m.Movement = movement
+	/* send it straight back out, away from the centre */
+	m.Movement = m.Position.Sub(centre).Normalize().Scale(g.baseVelocity)
 }
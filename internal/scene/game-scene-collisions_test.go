@@ -0,0 +1,246 @@
+package scene
+
+import (
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/entity"
+	"math/rand"
+	"testing"
+
+	"github.com/solarlune/resolv"
+)
+
+/* within edgeBand of an edge, shapes hang over it and collide across it */
+const edgeBand = 100
+
+/* swarm fills a headless scene with meteors and lasers centred wherever place puts them */
+func swarm(meteors, lasers int, place func(*rand.Rand) engine.Vector) *GameScene {
+	g := NewHeadlessGameScene(1)
+	rng := rand.New(rand.NewSource(1))
+
+	for range meteors {
+		m := entity.NewMeteor(rng, 0)
+		m.MoveTo(engine.CenterSprite(place(rng), m.Sprite))
+		g.entities.Add(m)
+	}
+
+	for range lasers {
+		g.SpawnLaser(place(rng), rng.Float64())
+	}
+
+	return g
+}
+
+/* scattered places centres anywhere on the screen */
+func scattered(rng *rand.Rand) engine.Vector {
+	return engine.Vector{X: rng.Float64() * engine.ScreenWidth, Y: rng.Float64() * engine.ScreenHeight}
+}
+
+/* nearEdges places centres within edgeBand of the left or right edge, then the top or bottom one */
+func nearEdges(rng *rand.Rand) engine.Vector {
+	pos := scattered(rng)
+	if rng.Intn(2) == 0 {
+		pos.X = rng.Float64()*2*edgeBand - edgeBand
+	} else {
+		pos.Y = rng.Float64()*2*edgeBand - edgeBand
+	}
+
+	return engine.Wrap(pos)
+}
+
+func TestTouchingMatchesAllPairs(t *testing.T) {
+	tests := []struct {
+		name  string
+		place func(*rand.Rand) engine.Vector
+	}{
+		{"scattered", scattered},
+		{"near edges", nearEdges},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := swarm(300, 200, tt.place)
+
+			lasers := entity.All[*entity.Laser](g.entities)
+			for k, m := range entity.All[*entity.Meteor](g.entities) {
+				want := 0
+				for _, l := range lasers {
+					if intersectsWrapped(m.Obj, l.Obj) {
+						want++
+					}
+				}
+
+				if got := len(engine.Touching(m.Obj, engine.TagLaser)); got != want {
+					t.Errorf("meteor %d touches %d lasers; all-pairs finds %d", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+/* intersectsWrapped is the brute force check: a against b and every copy of b across the edges */
+func intersectsWrapped(a, b resolv.IShape) bool {
+	home := b.Position()
+	defer b.SetPosition(home.X, home.Y)
+
+	for _, dy := range []float64{0, -engine.ScreenHeight, engine.ScreenHeight} {
+		for _, dx := range []float64{0, -engine.ScreenWidth, engine.ScreenWidth} {
+			b.SetPosition(home.X+dx, home.Y+dy)
+			if a.IsIntersecting(b) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func TestLaserHitsMeteorAcrossSeam(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+
+	/* a meteor hanging off the right edge shows its other half on the left */
+	m := entity.NewMeteor(g.rng, 0)
+	w, h := float64(m.Sprite.Bounds().Dx()), float64(m.Sprite.Bounds().Dy())
+	m.MoveTo(engine.Vector{X: engine.ScreenWidth - w/2 - 1, Y: engine.ScreenHeight/2 - h/2})
+	g.entities.Add(m)
+
+	/* across the bottom of the half on the left, since resolv only sees crossing outlines */
+	g.SpawnLaser(engine.Vector{X: 4, Y: engine.ScreenHeight/2 + h/2}, 0)
+
+	g.isMeteorHitByPlayerLaser()
+
+	if lasers := entity.Count[*entity.Laser](g.entities); lasers != 0 || g.score != points[targetLargeMeteor] {
+		t.Errorf("%d lasers left and score %d; the laser should hit the meteor across the edge", lasers, g.score)
+	}
+}
+
+func BenchmarkMeteorLaserCollisions(b *testing.B) {
+	g := swarm(500, 300, scattered)
+	meteors := entity.All[*entity.Meteor](g.entities)
+	lasers := entity.All[*entity.Laser](g.entities)
+
+	b.Run("space", func(b *testing.B) {
+		for range b.N {
+			for _, m := range meteors {
+				engine.Touching(m.Obj, engine.TagLaser)
+			}
+		}
+	})
+
+	/* every meteor is tried across an edge as well */
+	edges := entity.All[*entity.Meteor](swarm(500, 300, nearEdges).entities)
+	b.Run("space-edges", func(b *testing.B) {
+		for range b.N {
+			for _, m := range edges {
+				engine.Touching(m.Obj, engine.TagLaser)
+			}
+		}
+	})
+
+	b.Run("all-pairs", func(b *testing.B) {
+		for range b.N {
+			for _, m := range meteors {
+				for _, l := range lasers {
+					m.Obj.IsIntersecting(l.Obj)
+				}
+			}
+		}
+	})
+}
+
+func TestShieldDeflectsAlienLaser(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+	g.player.IsShielded = true
+	g.SetShield(entity.NewShield(g.player))
+	g.shield.Update(engine.Dt)
+
+	al := entity.NewAlienLaser(g.player.Position, 0)
+	g.entities.Add(al)
+
+	g.isPlayerHitByAlienLaser()
+
+	if !al.Deflected {
+		t.Error("laser inside the shield was not deflected")
+	}
+	if g.player.IsDying {
+		t.Error("shielded player was killed by an alien laser")
+	}
+
+	/* hostile fire never counts as the player's own */
+	if hits := engine.Touching(g.player.PlayerObj, engine.TagLaser); len(hits) != 0 {
+		t.Errorf("alien laser matched the player laser tag: %v", hits)
+	}
+}
+
+func TestLaserSplitsMeteor(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+
+	m := entity.NewMeteor(g.rng, 0)
+	m.MoveTo(engine.Vector{X: engine.ScreenWidth / 2, Y: engine.ScreenHeight / 2})
+	m.Movement = engine.Vector{X: 60}
+	g.entities.Add(m)
+
+	/* across the bottom of the meteor, since resolv only sees crossing outlines */
+	w, h := float64(m.Sprite.Bounds().Dx()), float64(m.Sprite.Bounds().Dy())
+	g.SpawnLaser(engine.Vector{X: m.Position.X + w/2, Y: m.Position.Y + h}, 0)
+
+	g.isMeteorHitByPlayerLaser()
+
+	if lasers := entity.Count[*entity.Laser](g.entities); lasers != 0 {
+		t.Errorf("%d lasers left; the hit should spend the laser", lasers)
+	}
+	if g.score != points[targetLargeMeteor] {
+		t.Errorf("score = %d, want %d", g.score, points[targetLargeMeteor])
+	}
+
+	var children []*entity.Meteor
+	for _, c := range entity.All[*entity.Meteor](g.entities) {
+		if c != m {
+			children = append(children, c)
+		}
+	}
+	if len(children) != 2 {
+		t.Fatalf("large meteor split into %d pieces, want 2", len(children))
+	}
+
+	for _, c := range children {
+		if c.Size != entity.MeteorMedium {
+			t.Errorf("piece size = %d, want medium", c.Size)
+		}
+		/* the laser fired straight up, so every piece keeps drifting right and heads up */
+		if c.Movement.X <= 0 || c.Movement.Y >= 0 {
+			t.Errorf("piece movement %+v lost the parent's momentum or the shot's push", c.Movement)
+		}
+	}
+}
+
+func TestExplodingMeteorIsHarmless(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+
+	centre := g.player.PlayerObj.Position()
+	m := entity.NewMeteor(g.rng, 0)
+	m.MoveTo(engine.CenterSprite(engine.Vector{X: centre.X, Y: centre.Y}, m.Sprite))
+	m.Movement = engine.Vector{X: 60}
+	g.entities.Add(m)
+
+	if !engine.CheckCollision(g.player.PlayerObj) {
+		t.Fatal("meteor over the player does not collide with it")
+	}
+
+	m.Explode()
+
+	/* a hyperspace jump may land on the wreck */
+	if engine.CheckCollision(g.player.PlayerObj) {
+		t.Error("exploding meteor still blocks the player")
+	}
+
+	g.isPlayerCollidingWithMeteor()
+	if g.player.IsDying {
+		t.Error("exploding meteor killed the player")
+	}
+
+	g.player.IsShielded = true
+	g.isPlayerCollidingWithMeteor()
+	if m.Movement != (engine.Vector{X: 60}) {
+		t.Errorf("shield bounced the exploding meteor to %+v", m.Movement)
+	}
+}
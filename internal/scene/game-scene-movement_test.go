@@ -0,0 +1,65 @@
+package scene
+
+import (
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/input"
+	"go-asteroids/internal/settings"
+	"math"
+	"testing"
+)
+
+/* fly steps the ship alone through ticks of the same controls and returns how far the last tick moved it */
+func fly(g *GameScene, in *input.Input, ticks int, actions ...input.Action) (dx, dy float64) {
+	var s input.Snapshot
+	for _, a := range actions {
+		s.Set(a)
+	}
+	in.SetFrame(input.NewFrame(s))
+
+	for range ticks {
+		before := g.player.Position
+		g.player.Update(in, engine.Dt)
+		dx, dy = g.player.Position.X-before.X, g.player.Position.Y-before.Y
+	}
+
+	return dx, dy
+}
+
+func TestNewtonianShipKeepsMomentum(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+	g.setMovement(settings.Newtonian)
+	in := input.New(nil, nil)
+
+	/* thrust straight up, then turn while coasting */
+	_, thrusting := fly(g, in, 30, input.Thrust)
+	if thrusting >= 0 {
+		t.Fatalf("thrusting moved the ship %v down; want up", thrusting)
+	}
+
+	dx, coasting := fly(g, in, 30, input.RotateRight)
+	if coasting >= 0 || coasting <= thrusting {
+		t.Errorf("coasting moved %v; want still heading up but slower than %v", coasting, thrusting)
+	}
+	if dx != 0 {
+		t.Errorf("turning while coasting drifted the ship sideways by %v", dx)
+	}
+}
+
+func TestNewtonianSpeedIsCapped(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+	g.setMovement(settings.Newtonian)
+	in := input.New(nil, nil)
+
+	limit := g.player.Handling.MaxSpeed * engine.Dt
+	for tick := range 600 {
+		dx, dy := fly(g, in, 1, input.Thrust)
+
+		/* a jump across the screen is the ship wrapping, not flying */
+		if math.Abs(dy) > engine.ScreenHeight/2 {
+			continue
+		}
+		if speed := math.Hypot(dx, dy); speed > limit+1e-9 {
+			t.Fatalf("tick %d: ship moved %v in a tick; want at most %v", tick, speed, limit)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package scene
+
+import (
+	"go-asteroids/internal/replay"
+	"go-asteroids/internal/settings"
+	"log"
+)
+
+// NewReplayScene plays back a recorded run through the normal GameScene update
+// path, at the recorded difficulty, movement and laser wrapping and the
+// volumes in s.
+func NewReplayScene(r *replay.Replay, s *settings.Settings) *GameScene {
+	g := NewGameScene(r.Seed, s)
+	g.playback = r
+	g.setDifficulty(r.Difficulty)
+	g.setMovement(r.Movement)
+	g.wrapLasers = r.WrapLasers
+
+	return g
+}
+
+/* readInput feeds this tick's controls to the simulation, from the player or the replay */
+func (g *GameScene) readInput(state *State) bool {
+	frame := state.Input.Frame()
+
+	if g.playback != nil {
+		if g.tick >= len(g.playback.Frames) {
+			log.Println("Replay ended before the game did")
+			return false
+		}
+		frame = g.playback.Frames[g.tick]
+	} else {
+		g.recording = append(g.recording, frame)
+	}
+
+	g.tick++
+	g.input.SetFrame(frame)
+
+	return true
+}
+
+func (g *GameScene) saveReplay() {
+	path, err := replay.Save(&replay.Replay{
+		Seed:       g.seed,
+		Difficulty: g.difficulty,
+		Movement:   g.movement,
+		WrapLasers: g.wrapLasers,
+		Score:      g.score,
+		Level:      g.currentLevel,
+		Frames:     g.recording,
+	})
+	if err != nil {
+		log.Println("Error saving replay", err)
+		return
+	}
+
+	log.Println("Saved replay", path)
+}
+
+func (g *GameScene) verifyReplay() {
+	if g.score != g.playback.Score || g.currentLevel != g.playback.Level {
+		log.Printf("Replay mismatch: got score %d on level %d, recorded score %d on level %d",
+			g.score, g.currentLevel, g.playback.Score, g.playback.Level)
+		return
+	}
+
+	log.Printf("Replay verified: score %d on level %d", g.score, g.currentLevel)
+}
@@ -0,0 +1,140 @@
+package scene
+
+import (
+	"fmt"
+	"go-asteroids/assets"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/entity"
+	"image/color"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+const (
+	/* kills closer together than comboWindow build a combo */
+	comboWindow        = 1500 * time.Millisecond
+	maxComboMultiplier = 4
+
+	popupLifetime = time.Second
+	popupRise     = 30.0 // pixels per second
+)
+
+/* target is anything the player can score from */
+type target int
+
+const (
+	targetLargeMeteor target = iota
+	targetMediumMeteor
+	targetSmallMeteor
+	targetLargeAlien
+	targetSmallAlien
+)
+
+/* points is the classic table: smaller targets are harder shots and worth more */
+var points = map[target]int{
+	targetLargeMeteor:  20,
+	targetMediumMeteor: 50,
+	targetSmallMeteor:  100,
+	targetLargeAlien:   200,
+	targetSmallAlien:   1000,
+}
+
+func meteorTarget(size entity.MeteorSize) target {
+	switch size {
+	case entity.MeteorLarge:
+		return targetLargeMeteor
+	case entity.MeteorMedium:
+		return targetMediumMeteor
+	default:
+		return targetSmallMeteor
+	}
+}
+
+func alienTarget(a *entity.Alien) target {
+	if a.Small {
+		return targetSmallAlien
+	}
+	return targetLargeAlien
+}
+
+/* award scores a kill at pos, multiplied by the running combo, and pays out any extra lives it earns */
+func (g *GameScene) award(t target, pos engine.Vector) {
+	if g.combo > 0 && !g.comboTimer.IsReady() {
+		g.combo++
+	} else {
+		g.combo = 1
+	}
+	g.comboTimer.Reset()
+
+	multiplier := min(g.combo, maxComboMultiplier)
+	g.score += points[t] * multiplier
+
+	label := fmt.Sprintf("%d", points[t])
+	if multiplier > 1 {
+		label = fmt.Sprintf("%d X%d", points[t], multiplier)
+	}
+	g.popups = append(g.popups, newScorePopup(label, pos))
+
+	g.awardExtraLives()
+}
+
+/* awardExtraLives grants a life for each threshold the score has passed, up to maxLives */
+func (g *GameScene) awardExtraLives() {
+	if g.tuning.extraLifeScore <= 0 {
+		return
+	}
+
+	for g.score >= g.nextExtraLife {
+		g.nextExtraLife += g.tuning.extraLifeScore
+
+		if g.player.LivesRemaining < maxLives {
+			g.player.LivesRemaining++
+			g.popups = append(g.popups, newScorePopup("EXTRA LIFE", g.player.Position))
+			g.sound.Play(soundShield)
+		}
+	}
+}
+
+/* updateScoring runs the combo clock and drifts the popups, dropping those that have faded */
+func (g *GameScene) updateScoring() {
+	g.comboTimer.Update()
+
+	live := g.popups[:0]
+	for _, p := range g.popups {
+		p.update(engine.Dt)
+		if !p.timer.IsReady() {
+			live = append(live, p)
+		}
+	}
+	clear(g.popups[len(live):])
+	g.popups = live
+}
+
+/* scorePopup is the points for a kill, rising and fading where it happened */
+type scorePopup struct {
+	label    string
+	position engine.Vector
+	timer    *engine.Timer
+}
+
+func newScorePopup(label string, pos engine.Vector) *scorePopup {
+	return &scorePopup{
+		label:    label,
+		position: pos,
+		timer:    engine.NewTimer(popupLifetime),
+	}
+}
+
+func (p *scorePopup) update(dt float64) {
+	p.position.Y -= popupRise * dt
+	p.timer.Update()
+}
+
+func (p *scorePopup) draw(screen *ebiten.Image) {
+	fade := 1 - p.timer.Progress()
+
+	clr := color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: uint8(fade * 0xff)}
+	drawText(screen, p.label, assets.ScoreFont, 14, p.position.X, p.position.Y, text.AlignCenter, clr)
+}
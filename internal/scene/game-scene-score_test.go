@@ -0,0 +1,76 @@
+package scene
+
+import (
+	"go-asteroids/internal/engine"
+	"testing"
+)
+
+func TestComboMultipliesQuickKills(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+
+	for range maxComboMultiplier + 1 {
+		g.award(targetLargeMeteor, engine.Vector{})
+	}
+
+	/* 1x, 2x, 3x, 4x, then held at the cap */
+	want := points[targetLargeMeteor] * (1 + 2 + 3 + 4 + maxComboMultiplier)
+	if g.score != want {
+		t.Errorf("combo score = %d; want %d", g.score, want)
+	}
+
+	/* let the window lapse and the chain starts over */
+	for !g.comboTimer.IsReady() {
+		g.updateScoring()
+	}
+	g.score = 0
+	g.award(targetSmallAlien, engine.Vector{})
+	if g.score != points[targetSmallAlien] {
+		t.Errorf("score after combo lapsed = %d; want %d", g.score, points[targetSmallAlien])
+	}
+}
+
+func TestExtraLifeThresholds(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+	every := g.tuning.extraLifeScore
+	lives := g.player.LivesRemaining
+
+	g.score = every - 1
+	g.awardExtraLives()
+	if g.player.LivesRemaining != lives {
+		t.Fatalf("life awarded below the threshold")
+	}
+
+	/* one jump past two thresholds pays out both */
+	g.score = 2 * every
+	g.awardExtraLives()
+	if g.player.LivesRemaining != lives+2 {
+		t.Errorf("lives = %d; want %d", g.player.LivesRemaining, lives+2)
+	}
+
+	g.score = 100 * every
+	g.awardExtraLives()
+	if g.player.LivesRemaining != maxLives {
+		t.Errorf("lives = %d; want capped at %d", g.player.LivesRemaining, maxLives)
+	}
+	if g.nextExtraLife <= g.score {
+		t.Errorf("next extra life at %d; want above %d", g.nextExtraLife, g.score)
+	}
+}
+
+func TestPopupsFade(t *testing.T) {
+	g := NewHeadlessGameScene(1)
+	g.award(targetMediumMeteor, engine.Vector{X: 100, Y: 100})
+
+	if len(g.popups) != 1 {
+		t.Fatalf("%d popups after a kill; want 1", len(g.popups))
+	}
+
+	steps := 0
+	for len(g.popups) > 0 && steps < 10*engine.StepRate {
+		g.updateScoring()
+		steps++
+	}
+	if lasted := engine.StepsToDuration(steps); lasted != popupLifetime {
+		t.Errorf("popup lasted %v; want %v", lasted, popupLifetime)
+	}
+}
@@ -6,6 +6,10 @@ import (
 	"go-asteroids/internal/engine"
 	"go-asteroids/internal/entity"
 	"go-asteroids/internal/highscore"
+	"go-asteroids/internal/input"
+	"go-asteroids/internal/replay"
+	"go-asteroids/internal/settings"
+	"go-asteroids/internal/sound"
 	"image/color"
 	"log"
 	"math"
@@ -13,164 +17,168 @@ import (
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 	"github.com/solarlune/resolv"
 )
 
 const (
-	baseMeteorVelocity   = 0.25
-	meteorSpawnTime      = 100 * time.Millisecond
-	meteorSpeedUpAmount  = 0.1
-	meteorSpeedUpTime    = 1000 * time.Millisecond
-	cleanupExplosionTime = 200 * time.Millisecond
-	baseBeatWaitTime     = 1600
-	numberOfStars        = 1000
-	alienAttackTime      = 3 * time.Second
-	alienSpawnTime       = 12 * time.Second
-	baseAlienVelocity    = 0.5
-
-	numberOfSmallMeteorsFromLargeMeteor = 4
+	meteorSpawnTime     = 100 * time.Millisecond
+	meteorSpeedUpAmount = 6.0
+	meteorSpeedUpTime   = 1000 * time.Millisecond
+	numberOfStars       = 1000
+	alienSpawnTime      = 12 * time.Second
+	baseAlienVelocity   = 30.0
+	maxLives            = 6
 )
 
 type GameScene struct {
-	player               *entity.Player
-	baseVelocity         float64
-	meteors              map[int]*entity.Meteor
-	meteorCount          int
-	meteorsPerLevel      int
-	meteorSpawnTimer     *engine.Timer
-	velocityTimer        *engine.Timer
-	space                *resolv.Space
-	lasers               map[int]*entity.Laser
-	laserCount           int
-	score                int
-	explosionSprite      *ebiten.Image
-	explosionSmallSprite *ebiten.Image
-	explosionFrames      []*ebiten.Image
-	cleanupTimer         *engine.Timer
-	playerIsDead         bool
-	audioContext         *audio.Context
-	thrustPlayer         *audio.Player
-	exhaust              *entity.Exhaust
-	laserOnePlayer       *audio.Player
-	laserTwoPlayer       *audio.Player
-	laserThreePlayer     *audio.Player
-	explosionPlayer      *audio.Player
-	beatOnePlayer        *audio.Player
-	beatTwoPlayer        *audio.Player
-	beatTimer            *engine.Timer
-	beatWaitTime         int
-	playBeatOne          bool
-	stars                []*entity.Star
-	currentLevel         int
-	shield               *entity.Shield
-	shieldsUpPlayer      *audio.Player
-	alienAttackTimer     *engine.Timer
-	alienCount           int
-	alienLaserCount      int
-	alienLaserPlayer     *audio.Player
-	alienLasers          map[int]*entity.AlienLaser
-	alienSoundPlayer     *audio.Player
-	alienSpawnTimer      *engine.Timer
-	aliens               map[int]*entity.Alien
-	highScore            int
-	originalHighScore    int
+	player            *entity.Player
+	baseVelocity      float64
+	entities          *entity.Registry
+	meteorCount       int
+	meteorsPerLevel   int
+	meteorSpawnTimer  *engine.Timer
+	velocityTimer     *engine.Timer
+	space             *resolv.Space
+	score             int
+	explosionFrames   []*ebiten.Image
+	playerIsDead      bool
+	exhaust           *entity.Exhaust
+	beatTimer         *engine.Timer
+	playBeatOne       bool
+	stars             []*entity.Star
+	currentLevel      int
+	shield            *entity.Shield
+	alienAttackTimer  *engine.Timer
+	alienSpawnTimer   *engine.Timer
+	highScore         int
+	originalHighScore int
+	highScores        highscore.Table
+	madeLeaderboard   bool
+	requestedSeed     int64
+	seed              int64
+	rng               *rand.Rand
+	input             *input.Input
+	tick              int
+	recording         []input.Frame
+	playback          *replay.Replay
+	headless          bool
+	settings          *settings.Settings
+	difficulty        settings.Difficulty
+	movement          settings.Movement
+	wrapLasers        bool
+	tuning            tuning
+	sound             *sound.Manager
+	combo             int
+	comboTimer        *engine.Timer
+	timers            engine.TimerGroup
+	nextExtraLife     int
+	popups            []*scorePopup
 }
 
 /* GameScene satisfies the narrow view entities depend on. */
 var _ entity.Scene = (*GameScene)(nil)
 
-func NewGameScene() *GameScene {
-	g := &GameScene{
-		baseVelocity:         baseMeteorVelocity,
-		meteors:              make(map[int]*entity.Meteor),
-		meteorCount:          0,
-		meteorsPerLevel:      2,
-		meteorSpawnTimer:     engine.NewTimer(meteorSpawnTime),
-		velocityTimer:        engine.NewTimer(meteorSpeedUpTime),
-		space:                resolv.NewSpace(engine.ScreenWidth, engine.ScreenHeight, 16, 16),
-		lasers:               make(map[int]*entity.Laser),
-		laserCount:           0,
-		explosionSprite:      assets.ExplosionSprite,
-		explosionSmallSprite: assets.ExplosionSmallSprite,
-		cleanupTimer:         engine.NewTimer(cleanupExplosionTime),
-		beatTimer:            engine.NewTimer(2 * time.Second),
-		beatWaitTime:         baseBeatWaitTime,
-		stars:                entity.GenerateStars(numberOfStars),
-		currentLevel:         1,
-		aliens:               make(map[int]*entity.Alien),
-		alienCount:           0,
-		alienLasers:          make(map[int]*entity.AlienLaser),
-		alienLaserCount:      0,
-		alienSpawnTimer:      engine.NewTimer(alienSpawnTime),
-		alienAttackTimer:     engine.NewTimer(alienAttackTime),
-	}
-
-	g.player = entity.NewPlayer(g)
-
-	g.space.Add(g.player.PlayerObj)
-
-	g.explosionFrames = assets.Explosion
+// NewGameScene starts a run from seed, or from a fresh seed if it is zero,
+// at the difficulty and volumes in s.
+func NewGameScene(seed int64, s *settings.Settings) *GameScene {
+	g := newGameScene(seed, s)
 
-	/* load audio */
-	g.audioContext = audio.NewContext(48000)
-	thrustPlayer, _ := g.audioContext.NewPlayer(assets.ThrustSound)
-	g.thrustPlayer = thrustPlayer
+	/* load the leaderboard */
+	table, err := highscore.Load()
+	if err != nil {
+		log.Println("Error loading high scores", err)
+	}
+	g.highScores = table
+	g.highScore = table.Best()
+	g.originalHighScore = g.highScore
 
-	laserOnePlayer, _ := g.audioContext.NewPlayer(assets.LaserOneSound)
-	g.laserOnePlayer = laserOnePlayer
+	return g
+}
 
-	laserTwoPlayer, _ := g.audioContext.NewPlayer(assets.LaserTwoSound)
-	g.laserTwoPlayer = laserTwoPlayer
+// NewHeadlessGameScene runs the same simulation on the default settings
+// without audio or touching the high score and replay stores, for tests and tools.
+func NewHeadlessGameScene(seed int64) *GameScene {
+	g := newGameScene(seed, settings.Default())
+	g.headless = true
 
-	laserThreePlayer, _ := g.audioContext.NewPlayer(assets.LaserThreeSound)
-	g.laserThreePlayer = laserThreePlayer
+	return g
+}
 
-	explosionPlayer, _ := g.audioContext.NewPlayer(assets.ExplosionSound)
-	g.explosionPlayer = explosionPlayer
+func newGameScene(seed int64, s *settings.Settings) *GameScene {
+	g := &GameScene{
+		requestedSeed:    seed,
+		settings:         s,
+		meteorCount:      0,
+		meteorsPerLevel:  2,
+		meteorSpawnTimer: engine.NewRepeatingTimer(meteorSpawnTime),
+		velocityTimer:    engine.NewRepeatingTimer(meteorSpeedUpTime),
+		space:            resolv.NewSpace(engine.ScreenWidth, engine.ScreenHeight, 16, 16),
+		beatTimer:        engine.NewRepeatingTimer(slowestBeat),
+		currentLevel:     1,
+		alienSpawnTimer:  engine.NewTimer(alienSpawnTime),
+		comboTimer:       engine.NewTimer(comboWindow),
+		alienAttackTimer: engine.NewTimer(0),
+		input:            input.New(nil, nil),
+	}
+	g.entities = entity.NewRegistry(g.space)
+
+	/* the scene's own clocks, held together while the game is paused */
+	g.timers.Add(g.meteorSpawnTimer, g.velocityTimer, g.beatTimer, g.alienSpawnTimer, g.comboTimer, g.alienAttackTimer)
+
+	g.startRun()
+	g.setDifficulty(s.Difficulty)
+	g.stars = entity.GenerateStars(g.rng, numberOfStars)
+
+	g.player = entity.NewPlayer(g, g.rng, handlings[s.Movement])
+	g.setMovement(s.Movement)
+	g.wrapLasers = s.WrapLasers
 
-	beatOnePlayer, _ := g.audioContext.NewPlayer(assets.BeatOneSound)
-	beatOnePlayer.SetVolume(0.5)
-	g.beatOnePlayer = beatOnePlayer
+	g.space.Add(g.player.PlayerObj)
 
-	beatTwoPlayer, _ := g.audioContext.NewPlayer(assets.BeatTwoSound)
-	beatTwoPlayer.SetVolume(0.5)
-	g.beatTwoPlayer = beatTwoPlayer
+	g.explosionFrames = assets.Explosion
 
-	shieldsUpPlayer, _ := g.audioContext.NewPlayer(assets.ShieldSound)
-	g.shieldsUpPlayer = shieldsUpPlayer
+	return g
+}
 
-	alienLaserPlayer, _ := g.audioContext.NewPlayer(assets.AlienLaserSound)
-	g.alienLaserPlayer = alienLaserPlayer
+/* setDifficulty picks the tuning for a run; replays override it with the recorded difficulty */
+func (g *GameScene) setDifficulty(d settings.Difficulty) {
+	g.difficulty = d
+	g.tuning = tunings[d]
+	g.baseVelocity = g.tuning.meteorVelocity
+	g.alienAttackTimer.SetDuration(g.tuning.alienAttackTime)
+	g.alienAttackTimer.Reset()
+	g.nextExtraLife = g.tuning.extraLifeScore
+}
 
-	alienSoundPlayer, _ := g.audioContext.NewPlayer(assets.AlienSound)
-	alienSoundPlayer.SetVolume(0.5)
-	g.alienSoundPlayer = alienSoundPlayer
+/* setMovement picks how the ship flies; like the difficulty, replays override it with the recorded profile */
+func (g *GameScene) setMovement(m settings.Movement) {
+	g.movement = m
+	g.player.Handling = handlings[m]
+}
 
-	/* load the current high score */
-	hs, err := highscore.Get()
-	if err != nil {
-		log.Println("Error getting high score", err)
+/* startRun reseeds the random sequence and clears the recording for a fresh run */
+func (g *GameScene) startRun() {
+	g.seed = g.requestedSeed
+	if g.seed == 0 {
+		g.seed = newSeed()
 	}
-	g.highScore = hs
-	g.originalHighScore = hs
+	g.rng = rand.New(rand.NewSource(g.seed))
 
-	return g
+	g.tick = 0
+	g.recording = nil
+	g.playback = nil
+	g.madeLeaderboard = false
 }
 
-func playOnce(p *audio.Player) {
-	if !p.IsPlaying() {
-		_ = p.Rewind()
-		p.Play()
-	}
+func newSeed() int64 {
+	return time.Now().UnixNano()
 }
 
 func (g *GameScene) SpawnLaser(pos engine.Vector, rotation float64) {
-	g.laserCount++
-	laser := entity.NewLaser(pos, rotation, g.laserCount)
-	g.lasers[g.laserCount] = laser
-	g.space.Add(laser.Obj)
+	laser := entity.NewLaser(pos, rotation)
+	laser.Wraps = g.wrapLasers
+	g.entities.Add(laser)
 }
 
 func (g *GameScene) SetExhaust(e *entity.Exhaust) {
@@ -192,32 +200,38 @@ func (g *GameScene) SetPlayerDead() {
 }
 
 func (g *GameScene) PlayThrust() {
-	playOnce(g.thrustPlayer)
+	g.sound.Play(soundThrust)
 }
 
 func (g *GameScene) PauseThrust() {
-	if g.thrustPlayer.IsPlaying() {
-		g.thrustPlayer.Pause()
-	}
+	g.sound.Stop(soundThrust)
 }
 
-func (g *GameScene) PlayLaserSound(shot int) {
-	switch shot {
-	case 1:
-		playOnce(g.laserOnePlayer)
-	case 2:
-		playOnce(g.laserTwoPlayer)
-	case 3:
-		playOnce(g.laserThreePlayer)
-	}
+/* each shot of a volley takes its own laser voice, so the sounds overlap */
+func (g *GameScene) PlayLaserSound() {
+	g.sound.Play(soundLaser)
 }
 
 func (g *GameScene) PlayShieldSound() {
-	playOnce(g.shieldsUpPlayer)
+	g.sound.Play(soundShield)
 }
 
 func (g *GameScene) Update(state *State) error {
-	g.player.Update()
+	/* entities play sounds from inside Update, through the manager the scene manager hands in */
+	g.sound = state.Sound
+
+	/* pause before reading input so a paused tick never reaches the recording */
+	if state.Input.IsJustPressed(input.Pause) || g.lostFocus() {
+		g.pause(state)
+		return nil
+	}
+
+	if !g.readInput(state) {
+		g.gameOver(state)
+		return nil
+	}
+
+	g.player.Update(g.input, engine.Dt)
 
 	g.updateExhaust()
 
@@ -231,26 +245,14 @@ func (g *GameScene) Update(state *State) error {
 
 	g.spawnAliens()
 
-	for _, a := range g.aliens {
-		a.Update()
-	}
-
 	g.letAliensAttack()
 
-	for _, al := range g.alienLasers {
-		al.Update()
-	}
-
-	for _, m := range g.meteors {
-		m.Update()
-	}
-
-	for _, l := range g.lasers {
-		l.Update()
-	}
+	g.entities.Update(engine.Dt)
 
 	g.speedUpMeteors()
 
+	g.updateScoring()
+
 	g.isPlayerCollidingWithMeteor()
 
 	g.isPlayerCollidingWithAlien()
@@ -261,21 +263,18 @@ func (g *GameScene) Update(state *State) error {
 
 	g.isMeteorHitByPlayerLaser()
 
-	g.cleanupMeteorsAndAliens()
+	/* drop what was shot, has burnt out or has left the screen */
+	g.entities.Sweep()
 
 	g.beatSound()
 
 	g.isLevelComplete(state)
 
-	g.removeOffscreenAliens()
-
-	g.removeOffscreenLasers()
-
 	return nil
 }
 
-func (g *GameScene) Draw(screen *ebiten.Image) {
-	g.player.Draw(screen)
+func (g *GameScene) Draw(screen *ebiten.Image, alpha float64) {
+	g.player.Draw(screen, alpha)
 
 	/* draw stars */
 	for _, s := range g.stars {
@@ -284,32 +283,20 @@ func (g *GameScene) Draw(screen *ebiten.Image) {
 
 	/* draw exhaust */
 	if g.exhaust != nil {
-		g.exhaust.Draw(screen)
+		g.exhaust.Draw(screen, alpha)
 	}
 
 	/* draw shield */
 	if g.shield != nil {
-		g.shield.Draw(screen)
-	}
-
-	/* draw meteors */
-	for _, m := range g.meteors {
-		m.Draw(screen)
-	}
-
-	/* draw lasers */
-	for _, l := range g.lasers {
-		l.Draw(screen)
+		g.shield.Draw(screen, alpha)
 	}
 
-	/* draw aliens  */
-	for _, a := range g.aliens {
-		a.Draw(screen)
-	}
+	/* draw meteors, lasers, aliens and their lasers */
+	g.entities.Draw(screen, alpha)
 
-	/* draw aliens lasers  */
-	for _, al := range g.alienLasers {
-		al.Draw(screen)
+	/* draw the points from recent kills where they happened */
+	for _, p := range g.popups {
+		p.draw(screen)
 	}
 
 	/* draw life, shield, and hyperspace indicators */
@@ -372,51 +359,23 @@ func (g *GameScene) Layout(width, height int) (int, int) {
 	return width, height
 }
 
-func (g *GameScene) beatSound() {
-	g.beatTimer.Update()
-	if g.beatTimer.IsReady() {
-		if g.playBeatOne {
-			_ = g.beatOnePlayer.Rewind()
-			g.beatOnePlayer.Play()
-			g.beatTimer.Reset()
-		} else {
-			_ = g.beatTwoPlayer.Rewind()
-			g.beatTwoPlayer.Play()
-			g.beatTimer.Reset()
-		}
-		g.playBeatOne = !g.playBeatOne
-		/* speed up timer */
-		if g.beatWaitTime > 400 {
-			g.beatWaitTime = g.beatWaitTime - 25
-			g.beatTimer = engine.NewTimer(time.Millisecond * time.Duration(g.beatWaitTime))
-		}
-	}
-}
-
 func (g *GameScene) updateExhaust() {
 	if g.exhaust != nil {
-		g.exhaust.Update()
+		g.exhaust.Update(engine.Dt)
 	}
 }
 
 func (g *GameScene) updateShield() {
 	if g.shield != nil {
-		g.shield.Update()
+		g.shield.Update(engine.Dt)
 	}
 }
 
 func (g *GameScene) spawnMeteors() {
-	g.meteorSpawnTimer.Update()
-	if g.meteorSpawnTimer.IsReady() {
-		g.meteorSpawnTimer.Reset()
-
-		if len(g.meteors) < g.meteorsPerLevel && g.meteorCount < g.meteorsPerLevel {
-			m := entity.NewMeteor(g.baseVelocity, len(g.meteors)-1)
-			/* add meteors to game space */
-			g.space.Add(m.Obj)
+	if g.meteorSpawnTimer.Update() > 0 {
+		if entity.Count[*entity.Meteor](g.entities) < g.meteorsPerLevel && g.meteorCount < g.meteorsPerLevel {
 			g.meteorCount++
-			g.meteors[g.meteorCount] = m
-
+			g.entities.Add(entity.NewMeteor(g.rng, g.baseVelocity))
 		}
 	}
 }
@@ -424,95 +383,36 @@ func (g *GameScene) spawnMeteors() {
 func (g *GameScene) spawnAliens() {
 	g.alienSpawnTimer.Update()
 
-	if len(g.aliens) != 0 {
+	if entity.Count[*entity.Alien](g.entities) != 0 {
 		return
 	}
 
 	if g.alienSpawnTimer.IsReady() {
 		g.alienSpawnTimer.Reset()
-		rnd := rand.Intn(100-1) + 1
+		rnd := g.rng.Intn(100-1) + 1
 
 		if rnd > 50 {
-			a := entity.NewAlien(baseAlienVelocity, g.player.Position)
-			g.space.Add(a.Obj)
-			g.alienCount++
-			g.aliens[g.alienCount] = a
-		}
-	}
-}
-
-func (g *GameScene) removeOffscreenAliens() {
-	for i, a := range g.aliens {
-		if a.Position.X > engine.ScreenWidth+200 ||
-			a.Position.Y > engine.ScreenHeight+200 ||
-			a.Position.X < -200 ||
-			a.Position.Y < -200 {
-			g.space.Remove(a.Obj)
-			delete(g.aliens, i)
-
-		}
-	}
-}
-
-func (g *GameScene) removeOffscreenLasers() {
-	for i, l := range g.lasers {
-		if l.Position.X > engine.ScreenWidth+200 ||
-			l.Position.Y > engine.ScreenHeight+200 ||
-			l.Position.X < -200 ||
-			l.Position.Y < -200 {
-			g.space.Remove(l.Obj)
-			delete(g.lasers, i)
-
-		}
-	}
-
-	for i, al := range g.alienLasers {
-		if al.Position.X > engine.ScreenWidth+200 ||
-			al.Position.Y > engine.ScreenHeight+200 ||
-			al.Position.X < -200 ||
-			al.Position.Y < -200 {
-			g.space.Remove(al.LaserObj)
-			delete(g.alienLasers, i)
-
+			g.entities.Add(entity.NewAlien(g.rng, baseAlienVelocity, g.player.Position))
 		}
 	}
 }
 
 func (g *GameScene) speedUpMeteors() {
-	g.velocityTimer.Update()
-	if g.velocityTimer.IsReady() {
-		g.velocityTimer.Reset()
+	for range g.velocityTimer.Update() {
 		g.baseVelocity += meteorSpeedUpAmount
 	}
 }
 
-func (g *GameScene) cleanupMeteorsAndAliens() {
-	g.cleanupTimer.Update()
-	if g.cleanupTimer.IsReady() {
-
-		/* clean up shot meteors */
-		for i, m := range g.meteors {
-			if m.Sprite == g.explosionSprite || m.Sprite == g.explosionSmallSprite {
-				delete(g.meteors, i)
-				g.space.Remove(m.Obj)
-			}
-		}
-
-		/* clean up dead aliens */
-		for i, a := range g.aliens {
-			if a.Sprite == g.explosionSprite || a.Sprite == g.explosionSmallSprite {
-				delete(g.aliens, i)
-				g.space.Remove(a.Obj)
-			}
-		}
-
-		g.cleanupTimer.Reset()
-	}
-}
-
 func (g *GameScene) letAliensAttack() {
-	if len(g.aliens) > 0 {
-		playOnce(g.alienSoundPlayer)
+	aliens := entity.All[*entity.Alien](g.entities)
+	if len(aliens) > 0 {
+		/* the hum follows the nearest alien */
+		hum := g.soundAt(g.nearestAlien().Position)
+		if g.sound.IsPlaying(soundAlien) {
+			g.sound.Move(soundAlien, hum)
+		} else {
+			g.sound.PlayAt(soundAlien, hum)
+		}
 
 		/* update the alien attack timer */
 		g.alienAttackTimer.Update()
@@ -521,7 +421,7 @@ func (g *GameScene) letAliensAttack() {
 		if g.alienAttackTimer.IsReady() {
 			g.alienAttackTimer.Reset()
 
-			for _, a := range g.aliens {
+			for _, a := range aliens {
 				bounds := a.Sprite.Bounds()
 				halfW := float64(bounds.Dx()) / 2
 				halfH := float64(bounds.Dy()) / 2
@@ -530,11 +430,10 @@ func (g *GameScene) letAliensAttack() {
 
 				if !a.IsIntelligent {
 					/* fire in a random direction */
-					degreesRadian = rand.Float64() * (math.Pi * 2)
+					degreesRadian = g.rng.Float64() * (math.Pi * 2)
 				} else {
 					/* fire with some accuracy */
-					degreesRadian = math.Atan2(g.player.Position.Y-a.Position.Y, g.player.Position.X-a.Position.X)
-					degreesRadian = degreesRadian - math.Pi*-0.5
+					degreesRadian = g.player.Position.Sub(a.Position).Angle() + math.Pi/2
 				}
 
 				r := degreesRadian
@@ -547,11 +446,9 @@ func (g *GameScene) letAliensAttack() {
 					Y: a.Position.Y + halfH + math.Cos(r) - offsetY,
 				}
 
-				laser := entity.NewAlienLaser(spawnPos, r)
-				g.alienLaserCount++
-				g.alienLasers[g.alienLaserCount] = laser
+				g.entities.Add(entity.NewAlienLaser(spawnPos, r))
 
-				playOnce(g.alienLaserPlayer)
+				g.sound.PlayAt(soundAlienLaser, g.soundAt(a.Position))
 			}
 		}
 	}
@@ -564,22 +461,20 @@ func (g *GameScene) isPlayerDying() {
 		return
 	}
 
-	g.player.DyingTimer.Update()
-	if !g.player.DyingTimer.IsReady() {
+	/* each firing of the repeating timer shows the next frame */
+	if g.player.DyingTimer.Update() == 0 {
 		return
 	}
 
-	g.player.DyingTimer.Reset()
-	g.player.DyingCounter++
-
-	if g.player.DyingCounter == maxDyingFrames {
+	frame := g.player.DyingTimer.Fired()
+	if frame == maxDyingFrames {
 		g.player.IsDying = false
 		g.player.IsDead = true
 		return
 	}
 
 	/* run animation */
-	g.player.Sprite = g.explosionFrames[g.player.DyingCounter]
+	g.player.Sprite = g.explosionFrames[frame]
 }
 
 func (g *GameScene) isPlayerDead(state *State) {
@@ -589,21 +484,8 @@ func (g *GameScene) isPlayerDead(state *State) {
 	g.player.LivesRemaining--
 
 	if g.player.LivesRemaining == 0 {
-		/* check for highscore */
-		if g.score > g.originalHighScore {
-			err := highscore.Update(g.score)
-			if err != nil {
-				log.Println(err)
-			}
-		}
-
-		/* go to gameover scene */
-		state.SceneManager.GoToScene(&GameOverScene{
-			game:        g,
-			meteors:     make(map[int]*entity.Meteor),
-			meteorCount: 5,
-			stars:       entity.GenerateStars(numberOfStars),
-		})
+		g.endRun()
+		g.gameOver(state)
 	} else {
 		/* keep score, lives, shields, and stars across the reset */
 		score := g.score
@@ -620,43 +502,75 @@ func (g *GameScene) isPlayerDead(state *State) {
 
 }
 
+/* endRun records the result of a live run, or checks it against the replay being played */
+func (g *GameScene) endRun() {
+	if g.playback != nil {
+		g.verifyReplay()
+		return
+	}
+
+	if g.headless {
+		return
+	}
+
+	/* initials are entered from the game over scene if the run made the leaderboard */
+	g.madeLeaderboard = g.highScores.Qualifies(g.score)
+
+	g.saveReplay()
+}
+
+func (g *GameScene) gameOver(state *State) {
+	state.SceneManager.GoToScene(&GameOverScene{
+		game:        g,
+		meteors:     make(map[int]*entity.Meteor),
+		meteorCount: 5,
+		stars:       entity.GenerateStars(g.rng, numberOfStars),
+		rng:         rand.New(rand.NewSource(newSeed())),
+	}, FadeThroughBlack(90))
+}
+
 func (g *GameScene) isLevelComplete(state *State) {
-	if g.meteorCount >= g.meteorsPerLevel && len(g.meteors) == 0 {
-		g.baseVelocity = baseMeteorVelocity
+	if g.meteorCount >= g.meteorsPerLevel && entity.Count[*entity.Meteor](g.entities) == 0 {
+		g.baseVelocity = g.tuning.meteorVelocity
 		g.currentLevel++
 
-		if g.currentLevel%5 == 0 {
-			if g.player.LivesRemaining < 6 {
-				g.player.LivesRemaining++
-			}
-		}
-
-		g.beatWaitTime = baseBeatWaitTime
+		g.resetBeat()
 
 		state.SceneManager.GoToScene(&LevelStartsScene{
 			game:           g,
 			nextLevelTimer: engine.NewTimer(time.Second * 2),
-			stars:          entity.GenerateStars(numberOfStars),
-		})
+			stars:          entity.GenerateStars(g.rng, numberOfStars),
+		}, Iris(40))
 	}
 }
 
+/* restart begins a brand new run at level one */
+func (g *GameScene) restart() {
+	g.timers.Resume()
+	g.startRun()
+	g.setDifficulty(g.settings.Difficulty)
+	g.setMovement(g.settings.Movement)
+	g.wrapLasers = g.settings.WrapLasers
+	g.Reset()
+	g.currentLevel = 1
+	g.meteorsPerLevel = 2
+	g.originalHighScore = g.highScores.Best()
+	g.highScore = g.originalHighScore
+}
+
 func (g *GameScene) Reset() {
-	g.player = entity.NewPlayer(g)
-	g.meteors = make(map[int]*entity.Meteor)
+	g.player = entity.NewPlayer(g, g.rng, handlings[g.movement])
 	g.meteorCount = 0
-	g.lasers = make(map[int]*entity.Laser)
-	g.laserCount = 0
 	g.score = 0
-	g.baseVelocity = baseMeteorVelocity
+	g.baseVelocity = g.tuning.meteorVelocity
 	g.velocityTimer.Reset()
 	g.meteorSpawnTimer.Reset()
 	g.playerIsDead = false
 	g.exhaust = nil
+	g.entities.Clear()
 	g.space.RemoveAll()
 	g.space.Add(g.player.PlayerObj)
-	g.aliens = make(map[int]*entity.Alien)
-	g.alienCount = 0
-	g.alienLasers = make(map[int]*entity.AlienLaser)
-	g.alienLaserCount = 0
+	g.combo = 0
+	g.popups = nil
+	g.resetBeat()
 }
@@ -4,9 +4,11 @@ import (
 	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
 	"go-asteroids/internal/entity"
+	"image/color"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/colorm"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
 /* HUD layout: rows of half-transparent icons in the top-left corner */
@@ -18,6 +20,17 @@ var (
 	hyperspacePosition = engine.Vector{X: 37, Y: 95}
 )
 
+/* cooldown bars sit where the icon they stand in for would be */
+const (
+	barWidth  = 40.0
+	barHeight = 4.0
+)
+
+var (
+	barBackground = color.RGBA{R: 0x40, G: 0x40, B: 0x40, A: 0x80}
+	barFill       = color.RGBA{R: 0xc0, G: 0xc0, B: 0xc0, A: 0x80}
+)
+
 func drawHUD(screen *ebiten.Image, p *entity.Player) {
 	for i := range p.LivesRemaining {
 		drawIndicator(screen, assets.LifeIndicator, engine.Vector{
@@ -33,11 +46,31 @@ func drawHUD(screen *ebiten.Image, p *entity.Player) {
 		})
 	}
 
+	/* the raised shield drains at the end of the shield row */
+	if p.IsShielded {
+		drawBar(screen, assets.ShieldIndicator, engine.Vector{
+			X: shieldRowOrigin.X + float64(p.ShieldsRemaining)*indicatorSpacing,
+			Y: shieldRowOrigin.Y,
+		}, p.ShieldLeft())
+	}
+
 	if p.HyperspaceReady() {
 		drawIndicator(screen, assets.HyperspaceIndicator, hyperspacePosition)
+	} else {
+		drawBar(screen, assets.HyperspaceIndicator, hyperspacePosition, p.HyperspaceCharge())
 	}
 }
 
+/* drawBar shows a cooldown filled to fraction, centred where drawIndicator would centre sprite */
+func drawBar(screen, sprite *ebiten.Image, pos engine.Vector, fraction float64) {
+	bounds := sprite.Bounds()
+	x := float32(pos.X+float64(bounds.Dx())) - barWidth/2
+	y := float32(pos.Y+float64(bounds.Dy())) - barHeight/2
+
+	vector.DrawFilledRect(screen, x, y, barWidth, barHeight, barBackground, false)
+	vector.DrawFilledRect(screen, x, y, float32(barWidth*fraction), barHeight, barFill, false)
+}
+
 func drawIndicator(screen, sprite *ebiten.Image, pos engine.Vector) {
 	bounds := sprite.Bounds()
 	halfW := float64(bounds.Dx()) / 2
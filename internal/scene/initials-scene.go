@@ -0,0 +1,136 @@
+package scene
+
+import (
+	"go-asteroids/assets"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/highscore"
+	"go-asteroids/internal/input"
+	"image/color"
+	"log"
+	"time"
+	"unicode"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	numberOfInitials = 3
+	initialsSpacing  = 60
+)
+
+/* InitialsScene is the arcade-style three letter entry pushed over the game over scene after a leaderboard run */
+type InitialsScene struct {
+	gameOver *GameOverScene
+	letters  [numberOfInitials]byte
+	cursor   int
+	saved    bool
+}
+
+func newInitialsScene(gameOver *GameOverScene) *InitialsScene {
+	return &InitialsScene{
+		gameOver: gameOver,
+		letters:  [numberOfInitials]byte{'A', 'A', 'A'},
+	}
+}
+
+func (s *InitialsScene) BlocksUpdate() bool { return true }
+func (s *InitialsScene) BlocksDraw() bool   { return false }
+
+func (s *InitialsScene) Draw(screen *ebiten.Image, _ float64) {
+	vector.DrawFilledRect(screen, 0, 0, engine.ScreenWidth, engine.ScreenHeight, overlayDim, false)
+
+	drawText(screen, "New High Score!", assets.TitleFont, 48, engine.ScreenWidth/2, engine.ScreenHeight/2-200, text.AlignCenter, color.White)
+	drawText(screen, "ENTER YOUR INITIALS", assets.ScoreFont, 20, engine.ScreenWidth/2, engine.ScreenHeight/2-80, text.AlignCenter, color.White)
+
+	left := engine.ScreenWidth/2 - float64(numberOfInitials-1)*initialsSpacing/2
+	for i, l := range s.letters {
+		var clr color.Color = dimmed
+		if i == s.cursor {
+			clr = color.White
+		}
+
+		drawText(screen, string(rune(l)), assets.TitleFont, 48, left+float64(i)*initialsSpacing, engine.ScreenHeight/2, text.AlignCenter, clr)
+	}
+}
+
+func (s *InitialsScene) Update(state *State) error {
+	if s.saved {
+		return nil
+	}
+
+	/* typed letters fill the current slot; skip the actions so a rebound letter key does not fire twice */
+	typed := false
+	for _, c := range state.Input.Chars() {
+		if c = unicode.ToUpper(c); c >= 'A' && c <= 'Z' {
+			s.letters[s.cursor] = byte(c)
+			s.advance(state)
+			typed = true
+
+			if s.saved {
+				return nil
+			}
+		}
+	}
+	if typed {
+		return nil
+	}
+
+	in := state.Input
+
+	if in.IsJustPressed(input.Thrust) {
+		s.letters[s.cursor] = nextLetter(s.letters[s.cursor], 1)
+	}
+
+	if in.IsJustPressed(input.Reverse) {
+		s.letters[s.cursor] = nextLetter(s.letters[s.cursor], -1)
+	}
+
+	if in.IsJustPressed(input.RotateLeft) && s.cursor > 0 {
+		s.cursor--
+	}
+
+	if in.IsJustPressed(input.RotateRight) && s.cursor < numberOfInitials-1 {
+		s.cursor++
+	}
+
+	if in.IsJustPressed(input.Confirm) {
+		s.advance(state)
+	}
+
+	return nil
+}
+
+/* advance moves to the next slot, saving the entry once after the last one */
+func (s *InitialsScene) advance(state *State) {
+	if s.cursor < numberOfInitials-1 {
+		s.cursor++
+		return
+	}
+
+	g := s.gameOver.game
+	table, rank := g.highScores.Insert(highscore.Entry{
+		Initials: string(s.letters[:]),
+		Score:    g.score,
+		Level:    g.currentLevel,
+		Date:     time.Now(),
+		Seed:     g.seed,
+	})
+
+	if err := highscore.Save(table); err != nil {
+		log.Println("Error saving high scores", err)
+	}
+
+	g.highScores = table
+	s.gameOver.entered = true
+	s.saved = true
+
+	state.SceneManager.PopScene()
+	state.SceneManager.PushScene(newLeaderboardScene(table, rank, s.gameOver.stars))
+}
+
+/* nextLetter steps through A-Z, wrapping at either end */
+func nextLetter(l byte, step int) byte {
+	return byte('A' + (int(l-'A')+step+26)%26)
+}
@@ -0,0 +1,71 @@
+package scene
+
+import (
+	"fmt"
+	"go-asteroids/assets"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/entity"
+	"go-asteroids/internal/highscore"
+	"go-asteroids/internal/input"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+const (
+	leaderboardTop        = 180
+	leaderboardRowSpacing = 36
+)
+
+/* LeaderboardScene is pushed over the title or game over scene to list the top ten */
+type LeaderboardScene struct {
+	table     highscore.Table
+	highlight int
+	stars     []*entity.Star
+}
+
+/* highlight is the index of a just-entered score, or -1 */
+func newLeaderboardScene(table highscore.Table, highlight int, stars []*entity.Star) *LeaderboardScene {
+	return &LeaderboardScene{
+		table:     table,
+		highlight: highlight,
+		stars:     stars,
+	}
+}
+
+func (l *LeaderboardScene) BlocksUpdate() bool { return true }
+func (l *LeaderboardScene) BlocksDraw() bool   { return true }
+
+func (l *LeaderboardScene) Draw(screen *ebiten.Image, _ float64) {
+	for _, s := range l.stars {
+		s.Draw(screen)
+	}
+
+	drawText(screen, "High Scores", assets.TitleFont, 48, engine.ScreenWidth/2, 60, text.AlignCenter, color.White)
+
+	if len(l.table) == 0 {
+		drawText(screen, "NO SCORES YET", assets.ScoreFont, 20, engine.ScreenWidth/2, leaderboardTop, text.AlignCenter, dimmed)
+	}
+
+	for i, e := range l.table {
+		var clr color.Color = dimmed
+		if i == l.highlight {
+			clr = color.White
+		}
+
+		row := fmt.Sprintf("%2d  %-3s  %06d  LEVEL %-3d  %s  SEED %d",
+			i+1, e.Initials, e.Score, e.Level, e.Date.Format("2006-01-02"), e.Seed)
+		drawText(screen, row, assets.ScoreFont, 18, engine.ScreenWidth/2, leaderboardTop+float64(i)*leaderboardRowSpacing, text.AlignCenter, clr)
+	}
+
+	drawText(screen, "PRESS CONFIRM TO CONTINUE", assets.ScoreFont, 16, engine.ScreenWidth/2, engine.ScreenHeight-60, text.AlignCenter, color.White)
+}
+
+func (l *LeaderboardScene) Update(state *State) error {
+	if state.Input.IsJustPressed(input.Confirm) || state.Input.IsJustPressed(input.Pause) {
+		state.SceneManager.PopScene()
+	}
+
+	return nil
+}
@@ -5,10 +5,10 @@ import (
 	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
 	"go-asteroids/internal/entity"
+	"go-asteroids/internal/input"
 	"image/color"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 )
 
@@ -18,7 +18,7 @@ type LevelStartsScene struct {
 	stars          []*entity.Star
 }
 
-func (l *LevelStartsScene) Draw(screen *ebiten.Image) {
+func (l *LevelStartsScene) Draw(screen *ebiten.Image, _ float64) {
 	for _, s := range l.stars {
 		s.Draw(screen)
 	}
@@ -46,7 +46,7 @@ func (l *LevelStartsScene) Update(state *State) error {
 		l.clearLasers(state)
 	}
 
-	if inpututil.IsKeyJustReleased(ebiten.KeySpace) {
+	if state.Input.IsJustReleased(input.Confirm) {
 		l.clearLasers(state)
 	}
 
@@ -58,10 +58,9 @@ func (l *LevelStartsScene) clearLasers(state *State) {
 	l.game.meteorCount = 0
 
 	/* clear lasers */
-	for k, v := range l.game.lasers {
-		delete(l.game.lasers, k)
-		l.game.space.Remove(v.Obj)
+	for _, laser := range entity.All[*entity.Laser](l.game.entities) {
+		l.game.entities.Remove(laser)
 	}
 
-	state.SceneManager.GoToScene(l.game)
+	state.SceneManager.GoToScene(l.game, Wipe(20))
 }
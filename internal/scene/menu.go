@@ -0,0 +1,59 @@
+package scene
+
+import (
+	"go-asteroids/assets"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/input"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+const menuItemSpacing = 36
+
+var dimmed = color.Gray{Y: 0x80}
+
+/* menu is a vertical list picked with thrust/reverse (up/down) and confirm */
+type menu struct {
+	items    []string
+	selected int
+}
+
+func newMenu(items ...string) *menu {
+	return &menu{items: items}
+}
+
+/* update moves the selection and returns the chosen item, if any, this tick */
+func (m *menu) update(state *State) (string, bool) {
+	in := state.Input
+
+	if in.IsJustPressed(input.Thrust) {
+		m.selected = (m.selected + len(m.items) - 1) % len(m.items)
+		state.Sound.Play(soundMenuMove)
+	}
+
+	if in.IsJustPressed(input.Reverse) {
+		m.selected = (m.selected + 1) % len(m.items)
+		state.Sound.Play(soundMenuMove)
+	}
+
+	if in.IsJustPressed(input.Confirm) {
+		state.Sound.Play(soundMenuSelect)
+		return m.items[m.selected], true
+	}
+
+	return "", false
+}
+
+/* draw lays the items out centred from y downwards, the selection at full brightness */
+func (m *menu) draw(screen *ebiten.Image, y float64) {
+	for i, item := range m.items {
+		var clr color.Color = dimmed
+		if i == m.selected {
+			clr = color.White
+		}
+
+		drawText(screen, item, assets.ScoreFont, 20, engine.ScreenWidth/2, y+float64(i)*menuItemSpacing, text.AlignCenter, clr)
+	}
+}
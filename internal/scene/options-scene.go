@@ -0,0 +1,188 @@
+package scene
+
+import (
+	"fmt"
+	"go-asteroids/assets"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/entity"
+	"go-asteroids/internal/input"
+	"go-asteroids/internal/settings"
+	"image/color"
+	"log"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+const volumeStep = 0.1
+
+/* the rows of the options menu, in display order */
+const (
+	optionMasterVolume = iota
+	optionMusicVolume
+	optionSFXVolume
+	optionMute
+	optionFullscreen
+	optionWindowScale
+	optionVSync
+	optionDifficulty
+	optionMovement
+	optionWrapLasers
+	optionControls
+	optionBack
+
+	optionCount
+)
+
+/* OptionsScene is pushed over the title or pause menu; changes apply as they are made and are saved on the way out */
+type OptionsScene struct {
+	settings *settings.Settings
+	stars    []*entity.Star
+	menu     *menu
+}
+
+func newOptionsScene(s *settings.Settings, stars []*entity.Star) *OptionsScene {
+	o := &OptionsScene{
+		settings: s,
+		stars:    stars,
+		menu:     newMenu(make([]string, optionCount)...),
+	}
+	o.refresh()
+
+	return o
+}
+
+func (o *OptionsScene) BlocksUpdate() bool { return true }
+func (o *OptionsScene) BlocksDraw() bool   { return true }
+
+func (o *OptionsScene) Draw(screen *ebiten.Image, _ float64) {
+	for _, s := range o.stars {
+		s.Draw(screen)
+	}
+
+	drawText(screen, "Options", assets.TitleFont, 48, engine.ScreenWidth/2, 60, text.AlignCenter, color.White)
+	o.menu.draw(screen, 180)
+	drawText(screen, "LEFT/RIGHT TO CHANGE", assets.ScoreFont, 16, engine.ScreenWidth/2, engine.ScreenHeight-60, text.AlignCenter, dimmed)
+}
+
+func (o *OptionsScene) Update(state *State) error {
+	in := state.Input
+
+	if in.IsJustPressed(input.Pause) {
+		o.close(state)
+		return nil
+	}
+
+	step := 0
+	if in.IsJustPressed(input.RotateLeft) {
+		step = -1
+	}
+	if in.IsJustPressed(input.RotateRight) {
+		step = 1
+	}
+
+	_, chosen := o.menu.update(state)
+	switch o.menu.selected {
+	case optionControls:
+		if chosen {
+			state.SceneManager.PushScene(newControlsScene(state.Input, o.stars))
+		}
+		return nil
+	case optionBack:
+		if chosen {
+			o.close(state)
+		}
+		return nil
+	}
+
+	/* confirm on a row steps it forwards, so every option works without left and right */
+	if chosen {
+		step = 1
+	}
+	if step != 0 {
+		o.change(o.menu.selected, step)
+		o.settings.Apply()
+		o.settings.ApplySound(state.Sound)
+		o.refresh()
+	}
+
+	return nil
+}
+
+func (o *OptionsScene) change(option, step int) {
+	s := o.settings
+
+	switch option {
+	case optionMasterVolume:
+		s.MasterVolume = stepVolume(s.MasterVolume, step)
+	case optionMusicVolume:
+		s.MusicVolume = stepVolume(s.MusicVolume, step)
+	case optionSFXVolume:
+		s.SFXVolume = stepVolume(s.SFXVolume, step)
+	case optionMute:
+		s.Muted = !s.Muted
+	case optionFullscreen:
+		s.Fullscreen = !s.Fullscreen
+	case optionWindowScale:
+		i := slices.Index(settings.WindowScales, s.WindowScale)
+		if i < 0 {
+			i = slices.Index(settings.WindowScales, 1)
+		}
+		i = min(max(i+step, 0), len(settings.WindowScales)-1)
+		s.WindowScale = settings.WindowScales[i]
+	case optionVSync:
+		s.VSync = !s.VSync
+	case optionDifficulty:
+		s.Difficulty = s.Difficulty.Next(step)
+	case optionMovement:
+		s.Movement = s.Movement.Next(step)
+	case optionWrapLasers:
+		s.WrapLasers = !s.WrapLasers
+	}
+}
+
+/* refresh rewrites the menu labels with the current values */
+func (o *OptionsScene) refresh() {
+	s := o.settings
+
+	o.menu.items[optionMasterVolume] = fmt.Sprintf("MASTER VOLUME  %s", volumeLabel(s.MasterVolume))
+	o.menu.items[optionMusicVolume] = fmt.Sprintf("MUSIC VOLUME  %s", volumeLabel(s.MusicVolume))
+	o.menu.items[optionSFXVolume] = fmt.Sprintf("EFFECTS VOLUME  %s", volumeLabel(s.SFXVolume))
+	o.menu.items[optionMute] = fmt.Sprintf("MUTE  %s", onOff(s.Muted))
+	o.menu.items[optionFullscreen] = fmt.Sprintf("FULLSCREEN  %s", onOff(s.Fullscreen))
+	o.menu.items[optionWindowScale] = fmt.Sprintf("WINDOW SIZE  %gX", s.WindowScale)
+	o.menu.items[optionVSync] = fmt.Sprintf("VSYNC  %s", onOff(s.VSync))
+	o.menu.items[optionDifficulty] = fmt.Sprintf("DIFFICULTY  %s", strings.ToUpper(s.Difficulty.String()))
+	o.menu.items[optionMovement] = fmt.Sprintf("FLIGHT  %s", strings.ToUpper(s.Movement.String()))
+	o.menu.items[optionWrapLasers] = fmt.Sprintf("WRAP SHOTS  %s", onOff(s.WrapLasers))
+	o.menu.items[optionControls] = "CONTROLS"
+	o.menu.items[optionBack] = menuBack
+}
+
+func (o *OptionsScene) close(state *State) {
+	if err := settings.Save(o.settings); err != nil {
+		log.Println("Error saving settings", err)
+	}
+
+	state.SceneManager.PopScene()
+}
+
+/* stepVolume moves a volume by one tenth, rounding so repeated steps land back on 0 and 1 exactly */
+func stepVolume(v float64, step int) float64 {
+	v = math.Round((v+float64(step)*volumeStep)*10) / 10
+	return min(max(v, 0), 1)
+}
+
+func volumeLabel(v float64) string {
+	return fmt.Sprintf("%d%%", int(math.Round(v*100)))
+}
+
+func onOff(b bool) string {
+	if b {
+		return "ON"
+	}
+	return "OFF"
+}
@@ -0,0 +1,85 @@
+package scene
+
+import (
+	"go-asteroids/assets"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/input"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	menuResume  = "RESUME"
+	menuRestart = "RESTART"
+	menuQuit    = "QUIT TO TITLE"
+)
+
+/* overlayDim darkens whatever an overlay is drawn over */
+var overlayDim = color.RGBA{A: 0xb0}
+
+/* PauseScene is pushed over a running game, freezing it and drawing it dimmed beneath a menu */
+type PauseScene struct {
+	game *GameScene
+	menu *menu
+}
+
+func newPauseScene(g *GameScene) *PauseScene {
+	return &PauseScene{
+		game: g,
+		menu: newMenu(menuResume, menuRestart, menuOptions, menuQuit),
+	}
+}
+
+func (p *PauseScene) BlocksUpdate() bool { return true }
+func (p *PauseScene) BlocksDraw() bool   { return false }
+
+func (p *PauseScene) Draw(screen *ebiten.Image, _ float64) {
+	vector.DrawFilledRect(screen, 0, 0, engine.ScreenWidth, engine.ScreenHeight, overlayDim, false)
+
+	drawText(screen, "Paused", assets.TitleFont, 48, engine.ScreenWidth/2, engine.ScreenHeight/2-140, text.AlignCenter, color.White)
+	p.menu.draw(screen, engine.ScreenHeight/2-20)
+}
+
+func (p *PauseScene) Update(state *State) error {
+	if state.Input.IsJustPressed(input.Pause) {
+		p.resume(state)
+		return nil
+	}
+
+	switch item, _ := p.menu.update(state); item {
+	case menuResume:
+		p.resume(state)
+	case menuRestart:
+		state.Sound.StopAll()
+		p.game.restart()
+		state.SceneManager.PopScene()
+	case menuOptions:
+		state.SceneManager.PushScene(newOptionsScene(p.game.settings, p.game.stars))
+	case menuQuit:
+		state.Sound.StopAll()
+		state.SceneManager.GoToScene(NewTitleScene(p.game.requestedSeed, p.game.settings), FadeThroughBlack(40))
+	}
+
+	return nil
+}
+
+func (p *PauseScene) resume(state *State) {
+	p.game.timers.Resume()
+	state.Sound.ResumeAll()
+	state.SceneManager.PopScene()
+}
+
+/* pause freezes the game: it stops updating, its timers are held, and so are its sounds until resume */
+func (g *GameScene) pause(state *State) {
+	g.timers.Pause()
+	state.Sound.PauseAll()
+	state.SceneManager.PushScene(newPauseScene(g))
+}
+
+/* lostFocus auto-pauses when the window is in the background; headless runs have no window */
+func (g *GameScene) lostFocus() bool {
+	return !g.headless && !ebiten.IsFocused()
+}
@@ -2,6 +2,8 @@ package scene
 
 import (
 	"go-asteroids/internal/engine"
+	"go-asteroids/internal/input"
+	"go-asteroids/internal/sound"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -11,57 +13,75 @@ var (
 	transitionTo   = ebiten.NewImage(engine.ScreenWidth, engine.ScreenHeight)
 )
 
-const transitionMaxCount = 25
-
 type Scene interface {
-	Draw(screen *ebiten.Image)
+	/* Draw renders the scene alpha of the way from its previous simulation step to its current one */
+	Draw(screen *ebiten.Image, alpha float64)
 	Update(state *State) error
 }
 
+// Layer is implemented by scenes pushed with PushScene to say whether the
+// scenes beneath them keep updating and drawing. A pushed scene that does not
+// implement it blocks both.
+type Layer interface {
+	BlocksUpdate() bool
+	BlocksDraw() bool
+}
+
 type State struct {
 	SceneManager *SceneManager
 	Input        *Input
+	Sound        *sound.Manager
 }
 
-/* Input is a stub input source threaded through the scene manager. */
-type Input struct{}
-
-func (i *Input) Update() {}
+/* Input is the action-mapping layer threaded through the scene manager. */
+type Input = input.Input
 
+/* SceneManager holds a stack of scenes, bottom first, and transitions between stacks */
 type SceneManager struct {
-	current         Scene
+	/* Sound is handed to scenes with each update; nil leaves them silent */
+	Sound *sound.Manager
+
+	stack           []Scene
 	next            Scene
+	transition      Transition
 	transitionCount int
 }
 
-func (s *SceneManager) Draw(r *ebiten.Image) {
+func (s *SceneManager) Draw(r *ebiten.Image, alpha float64) {
 	if s.transitionCount == 0 {
-		s.current.Draw(r)
+		s.drawStack(r, alpha)
 		return
 	}
 
 	transitionFrom.Clear()
-	s.current.Draw(transitionFrom)
+	s.drawStack(transitionFrom, alpha)
 
 	transitionTo.Clear()
-	s.next.Draw(transitionTo)
+	s.next.Draw(transitionTo, alpha)
 
-	r.DrawImage(transitionFrom, nil)
-
-	alpha := 1 - float32(s.transitionCount)/float32(transitionMaxCount)
-	op := &ebiten.DrawImageOptions{}
+	s.transition.Draw(r, transitionFrom, transitionTo, s.progress())
+}
 
-	op.ColorScale.ScaleAlpha(alpha)
+/* progress is how far through the running transition the manager is, from 0 up to 1 */
+func (s *SceneManager) progress() float32 {
+	return 1 - float32(s.transitionCount)/float32(s.transition.Ticks())
+}
 
-	r.DrawImage(transitionTo, op)
+/* drawStack draws from the lowest scene left visible by the layers above it */
+func (s *SceneManager) drawStack(r *ebiten.Image, alpha float64) {
+	bottom := len(s.stack) - 1
+	for bottom > 0 && !blocks(s.stack[bottom], Layer.BlocksDraw) {
+		bottom--
+	}
 
+	for _, scene := range s.stack[max(bottom, 0):] {
+		scene.Draw(r, alpha)
+	}
 }
 
-func (s *SceneManager) Update(_ *Input) error {
+func (s *SceneManager) Update(in *Input) error {
 	if s.transitionCount == 0 {
-		return s.current.Update(&State{
-			SceneManager: s,
-		})
+		return s.updateStack(in)
 	}
 
 	s.transitionCount--
@@ -69,17 +89,78 @@ func (s *SceneManager) Update(_ *Input) error {
 		return nil
 	}
 
-	s.current = s.next
+	s.stack = []Scene{s.next}
 	s.next = nil
+	s.transition = nil
 
 	return nil
 }
 
-func (s *SceneManager) GoToScene(scene Scene) {
-	if s.current == nil {
-		s.current = scene
-	} else {
-		s.next = scene
-		s.transitionCount = transitionMaxCount
+/* updateStack updates from the top down until a layer blocks the rest, or a scene changes the stack */
+func (s *SceneManager) updateStack(in *Input) error {
+	state := &State{
+		SceneManager: s,
+		Input:        in,
+		Sound:        s.Sound,
+	}
+
+	stack := s.stack
+	for i := len(stack) - 1; i >= 0; i-- {
+		if err := stack[i].Update(state); err != nil {
+			return err
+		}
+
+		if s.changed(stack) || blocks(stack[i], Layer.BlocksUpdate) {
+			return nil
+		}
 	}
+
+	return nil
+}
+
+// GoToScene replaces the whole stack with scene by way of t. The very first
+// scene is shown immediately whatever t is.
+func (s *SceneManager) GoToScene(scene Scene, t Transition) {
+	if len(s.stack) == 0 || t.Ticks() <= 0 {
+		s.stack = []Scene{scene}
+		s.next = nil
+		s.transitionCount = 0
+		return
+	}
+
+	s.next = scene
+	s.transition = t
+	s.transitionCount = t.Ticks()
+}
+
+// PushScene puts scene on top of the stack immediately.
+func (s *SceneManager) PushScene(scene Scene) {
+	n := len(s.stack)
+	s.stack = append(s.stack[:n:n], scene)
+}
+
+// PopScene removes the top scene, uncovering the one beneath it.
+func (s *SceneManager) PopScene() {
+	if n := len(s.stack) - 1; n > 0 {
+		s.stack = s.stack[:n:n]
+	}
+}
+
+// Current is the scene on top of the stack.
+func (s *SceneManager) Current() Scene {
+	if len(s.stack) == 0 {
+		return nil
+	}
+	return s.stack[len(s.stack)-1]
+}
+
+/* changed reports whether the stack was pushed, popped or replaced since it was captured */
+func (s *SceneManager) changed(captured []Scene) bool {
+	return s.next != nil || len(s.stack) != len(captured) || s.Current() != captured[len(captured)-1]
+}
+
+/* blocks asks a pushed scene's Layer, treating scenes without one as blocking */
+func blocks(scene Scene, check func(Layer) bool) bool {
+	l, ok := scene.(Layer)
+	return !ok || check(l)
 }
@@ -0,0 +1,61 @@
+package scene
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type countingScene struct {
+	updates int
+}
+
+func (c *countingScene) Draw(_ *ebiten.Image, _ float64) {}
+
+func (c *countingScene) Update(_ *State) error {
+	c.updates++
+	return nil
+}
+
+type countingLayer struct {
+	countingScene
+	blockUpdate bool
+}
+
+func (c *countingLayer) BlocksUpdate() bool { return c.blockUpdate }
+func (c *countingLayer) BlocksDraw() bool   { return false }
+
+func TestPushedLayersControlUpdatesBeneath(t *testing.T) {
+	base := &countingScene{}
+	sm := &SceneManager{}
+	sm.GoToScene(base, Cut())
+
+	hud := &countingLayer{}
+	sm.PushScene(hud)
+	if err := sm.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+	if base.updates != 1 || hud.updates != 1 {
+		t.Fatalf("non-blocking layer: base %d, layer %d updates; want 1 and 1", base.updates, hud.updates)
+	}
+
+	modal := &countingLayer{blockUpdate: true}
+	sm.PushScene(modal)
+	if err := sm.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+	if base.updates != 1 || hud.updates != 1 || modal.updates != 1 {
+		t.Fatalf("blocking layer: base %d, hud %d, modal %d updates; want 1, 1, 1", base.updates, hud.updates, modal.updates)
+	}
+
+	sm.PopScene()
+	if sm.Current() != hud {
+		t.Fatal("pop should uncover the previous layer")
+	}
+
+	sm.PopScene()
+	sm.PopScene()
+	if sm.Current() != base {
+		t.Fatal("the bottom scene should never be popped")
+	}
+}
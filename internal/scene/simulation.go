@@ -0,0 +1,117 @@
+package scene
+
+import (
+	"go-asteroids/internal/input"
+	"go-asteroids/internal/replay"
+	"slices"
+)
+
+// Script supplies the controls for each tick of a headless run.
+type Script func(tick int) input.Frame
+
+// Result is where a headless run ended up.
+type Result struct {
+	Seed           int64
+	Ticks          int
+	Score          int
+	Level          int
+	LivesRemaining int
+	GameOver       bool
+}
+
+// Simulation steps a headless GameScene through the scene manager, level
+// starts and transitions included, without a window or audio device.
+type Simulation struct {
+	game    *GameScene
+	manager *SceneManager
+	input   *Input
+	script  Script
+	tick    int
+}
+
+// NewSimulation starts a headless run from seed driven by script; a nil script never touches the controls.
+func NewSimulation(seed int64, script Script) *Simulation {
+	return newSimulation(NewHeadlessGameScene(seed), script)
+}
+
+// NewReplaySimulation plays a recorded run back headlessly.
+func NewReplaySimulation(r *replay.Replay) *Simulation {
+	g := NewHeadlessGameScene(r.Seed)
+	g.playback = r
+	g.setDifficulty(r.Difficulty)
+	g.setMovement(r.Movement)
+	g.wrapLasers = r.WrapLasers
+
+	return newSimulation(g, nil)
+}
+
+func newSimulation(g *GameScene, script Script) *Simulation {
+	s := &Simulation{
+		game:    g,
+		manager: &SceneManager{},
+		input:   input.New(nil, nil),
+		script:  script,
+	}
+	s.manager.GoToScene(g, Cut())
+
+	return s
+}
+
+// Step advances the simulation by one tick.
+func (s *Simulation) Step() error {
+	var f input.Frame
+	if s.script != nil {
+		f = s.script(s.tick)
+	}
+	s.input.SetFrame(f)
+	s.tick++
+
+	return s.manager.Update(s.input)
+}
+
+// Run steps up to ticks times, stopping early once the game is over.
+func (s *Simulation) Run(ticks int) (Result, error) {
+	for range ticks {
+		if s.isGameOver() {
+			break
+		}
+		if err := s.Step(); err != nil {
+			return s.Result(), err
+		}
+	}
+
+	return s.Result(), nil
+}
+
+func (s *Simulation) Result() Result {
+	return Result{
+		Seed:           s.game.seed,
+		Ticks:          s.tick,
+		Score:          s.game.score,
+		Level:          s.game.currentLevel,
+		LivesRemaining: s.game.player.LivesRemaining,
+		GameOver:       s.isGameOver(),
+	}
+}
+
+// Replay returns the input recorded so far, ready to save or play back.
+func (s *Simulation) Replay() *replay.Replay {
+	return &replay.Replay{
+		Seed:       s.game.seed,
+		Difficulty: s.game.difficulty,
+		Movement:   s.game.movement,
+		WrapLasers: s.game.wrapLasers,
+		Score:      s.game.score,
+		Level:      s.game.currentLevel,
+		Frames:     s.game.recording,
+	}
+}
+
+func (s *Simulation) isGameOver() bool {
+	_, next := s.manager.next.(*GameOverScene)
+
+	return next || slices.ContainsFunc(s.manager.stack, func(scene Scene) bool {
+		_, over := scene.(*GameOverScene)
+		return over
+	})
+}
@@ -0,0 +1,74 @@
+package scene
+
+import (
+	"go-asteroids/internal/input"
+	"testing"
+)
+
+/* circleAndShoot spins the ship while firing, thrusting in short bursts */
+func circleAndShoot(tick int) input.Frame {
+	var s input.Snapshot
+	s.Set(input.RotateRight)
+	s.Set(input.Fire)
+	if tick%120 < 20 {
+		s.Set(input.Thrust)
+	}
+
+	return input.NewFrame(s)
+}
+
+func TestSimulationIsDeterministic(t *testing.T) {
+	first, err := NewSimulation(42, circleAndShoot).Run(5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := NewSimulation(42, circleAndShoot).Run(5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first != second {
+		t.Fatalf("same seed and script diverged: %+v vs %+v", first, second)
+	}
+}
+
+/* golden runs pin the simulation itself; when a change moves them on purpose, bump replay.Version too */
+func TestSimulationGoldenRuns(t *testing.T) {
+	tests := []struct {
+		seed  int64
+		ticks int
+		want  Result
+	}{
+		{seed: 42, ticks: 600, want: Result{Seed: 42, Ticks: 600, Score: 90, Level: 1, LivesRemaining: 2}},
+		{seed: 42, ticks: 5000, want: Result{Seed: 42, Ticks: 1553, Score: 280, Level: 1, GameOver: true}},
+	}
+
+	for _, tt := range tests {
+		got, err := NewSimulation(tt.seed, circleAndShoot).Run(tt.ticks)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != tt.want {
+			t.Errorf("seed %d for %d ticks = %+v, want %+v", tt.seed, tt.ticks, got, tt.want)
+		}
+	}
+}
+
+func TestReplayReproducesRun(t *testing.T) {
+	live := NewSimulation(7, circleAndShoot)
+	want, err := live.Run(3000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := NewReplaySimulation(live.Replay()).Run(10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Score != want.Score || got.Level != want.Level || got.LivesRemaining != want.LivesRemaining {
+		t.Fatalf("replay ended at %+v, live run at %+v", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+package scene
+
+import (
+	"go-asteroids/assets"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/entity"
+	"go-asteroids/internal/sound"
+	"io"
+	"math"
+)
+
+/* names the scenes play sounds by */
+const (
+	soundThrust     = "thrust"
+	soundLaser      = "laser"
+	soundExplosion  = "explosion"
+	soundBeatOne    = "beat-one"
+	soundBeatTwo    = "beat-two"
+	soundShield     = "shield"
+	soundAlien      = "alien"
+	soundAlienLaser = "alien-laser"
+	soundMenuMove   = soundLaser
+	soundMenuSelect = soundShield
+)
+
+const (
+	/* emitters this far from the player or further play at minSoundGain */
+	hearingRange = 1000.0
+	minSoundGain = 0.3
+)
+
+// LoadSounds registers every game sound with m.
+func LoadSounds(m *sound.Manager) error {
+	sounds := []struct {
+		name   string
+		src    io.Reader
+		bus    sound.Bus
+		volume float64
+		voices int
+	}{
+		{soundThrust, assets.ThrustSound, sound.SFX, 1, 1},
+		{soundLaser, assets.LaserSound, sound.SFX, 1, 3},
+		{soundExplosion, assets.ExplosionSound, sound.SFX, 1, 3},
+		{soundBeatOne, assets.BeatOneSound, sound.Music, 0.5, 1},
+		{soundBeatTwo, assets.BeatTwoSound, sound.Music, 0.5, 1},
+		{soundShield, assets.ShieldSound, sound.SFX, 1, 1},
+		{soundAlien, assets.AlienSound, sound.SFX, 0.5, 1},
+		{soundAlienLaser, assets.AlienLaserSound, sound.SFX, 1, 2},
+	}
+
+	for _, s := range sounds {
+		if err := m.Register(s.name, s.src, s.bus, s.volume, s.voices); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* soundAt pans an emitter by where it is across the screen and fades it with distance from the player */
+func (g *GameScene) soundAt(pos engine.Vector) sound.Position {
+	pan := (pos.X - engine.ScreenWidth/2) / (engine.ScreenWidth / 2)
+	distance := engine.WrapDistance(pos, g.player.Position)
+
+	return sound.Position{
+		Pan:  pan,
+		Gain: max(minSoundGain, 1-(1-minSoundGain)*distance/hearingRange),
+	}
+}
+
+func (g *GameScene) nearestAlien() *entity.Alien {
+	var nearest *entity.Alien
+	best := math.Inf(1)
+	for _, a := range entity.All[*entity.Alien](g.entities) {
+		if d := a.Position.Distance(g.player.Position); d < best {
+			nearest, best = a, d
+		}
+	}
+
+	return nearest
+}
@@ -0,0 +1,25 @@
+package scene
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+/* drawText draws str with its top edge at y, aligned on x per align */
+func drawText(screen *ebiten.Image, str string, source *text.GoTextFaceSource, size float64, x, y float64, align text.Align, clr color.Color) {
+	op := &text.DrawOptions{
+		LayoutOptions: text.LayoutOptions{
+			PrimaryAlign: align,
+		},
+	}
+
+	op.ColorScale.ScaleWithColor(clr)
+	op.GeoM.Translate(x, y)
+
+	text.Draw(screen, str, &text.GoTextFace{
+		Source: source,
+		Size:   size,
+	}, op)
+}
@@ -4,10 +4,13 @@ import (
 	"go-asteroids/assets"
 	"go-asteroids/internal/engine"
 	"go-asteroids/internal/entity"
+	"go-asteroids/internal/highscore"
+	"go-asteroids/internal/settings"
 	"image/color"
+	"log"
+	"math/rand"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 )
 
@@ -15,16 +18,38 @@ type TitleScene struct {
 	meteors     map[int]*entity.Meteor
 	meteorCount int
 	stars       []*entity.Star
+	seed        int64
+	settings    *settings.Settings
+	rng         *rand.Rand
+	menu        *menu
 }
 
-func NewTitleScene() *TitleScene {
+/* the meteors drifting behind the title and game over screens, in pixels per second */
+const titleMeteorVelocity = 15.0
+
+const (
+	menuStart      = "START"
+	menuHighScores = "HIGH SCORES"
+	menuOptions    = "OPTIONS"
+)
+
+// NewTitleScene shows the title; seed is handed to the game it starts (zero
+// picks one per run), and s is the settings the options menu edits.
+func NewTitleScene(seed int64, s *settings.Settings) *TitleScene {
+	/* the backdrop is cosmetic, so it never draws from the gameplay seed */
+	rng := rand.New(rand.NewSource(newSeed()))
+
 	return &TitleScene{
-		meteors: make(map[int]*entity.Meteor),
-		stars:   entity.GenerateStars(numberOfStars),
+		meteors:  make(map[int]*entity.Meteor),
+		stars:    entity.GenerateStars(rng, numberOfStars),
+		seed:     seed,
+		settings: s,
+		rng:      rng,
+		menu:     newMenu(menuStart, menuHighScores, menuOptions),
 	}
 }
 
-func (t *TitleScene) Draw(screen *ebiten.Image) {
+func (t *TitleScene) Draw(screen *ebiten.Image, alpha float64) {
 	/* draw stars */
 	for _, s := range t.stars {
 		s.Draw(screen)
@@ -48,25 +73,37 @@ func (t *TitleScene) Draw(screen *ebiten.Image) {
 
 	/* draw meteors */
 	for _, m := range t.meteors {
-		m.Draw(screen)
+		m.Draw(screen, alpha)
 	}
 
+	t.menu.draw(screen, engine.ScreenHeight-120)
 }
 
 func (t *TitleScene) Update(state *State) error {
-	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-		state.SceneManager.GoToScene(NewGameScene())
+	switch item, _ := t.menu.update(state); item {
+	case menuStart:
+		state.SceneManager.GoToScene(NewGameScene(t.seed, t.settings), CrossFade(25))
+		return nil
+	case menuHighScores:
+		table, err := highscore.Load()
+		if err != nil {
+			log.Println("Error loading high scores", err)
+		}
+		state.SceneManager.PushScene(newLeaderboardScene(table, -1, t.stars))
+		return nil
+	case menuOptions:
+		state.SceneManager.PushScene(newOptionsScene(t.settings, t.stars))
 		return nil
 	}
 
 	/* add some meteors */
 	if len(t.meteors) < 10 {
-		m := entity.NewMeteor(0.25, len(t.meteors)-1)
+		m := entity.NewMeteor(t.rng, titleMeteorVelocity)
 		t.meteorCount++
 		t.meteors[t.meteorCount] = m
 	}
 	for _, m := range t.meteors {
-		m.Update()
+		m.Update(engine.Dt)
 	}
 
 	return nil
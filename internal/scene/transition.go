@@ -0,0 +1,104 @@
+package scene
+
+import (
+	"go-asteroids/internal/engine"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+var irisMask = ebiten.NewImage(engine.ScreenWidth, engine.ScreenHeight)
+
+// Transition composites the outgoing and incoming scenes while SceneManager
+// switches between them.
+type Transition interface {
+	// Ticks is how long the transition runs; zero switches immediately.
+	Ticks() int
+	// Draw renders the frame progress of the way through, from 0 to 1.
+	Draw(screen, from, to *ebiten.Image, progress float32)
+}
+
+type crossFade struct{ ticks int }
+
+// CrossFade blends the incoming scene in over the outgoing one.
+func CrossFade(ticks int) Transition { return crossFade{ticks} }
+
+func (t crossFade) Ticks() int { return t.ticks }
+
+func (t crossFade) Draw(screen, from, to *ebiten.Image, progress float32) {
+	screen.DrawImage(from, nil)
+
+	op := &ebiten.DrawImageOptions{}
+	op.ColorScale.ScaleAlpha(progress)
+	screen.DrawImage(to, op)
+}
+
+type fadeThroughBlack struct{ ticks int }
+
+// FadeThroughBlack fades the outgoing scene out to black, then the incoming one in.
+func FadeThroughBlack(ticks int) Transition { return fadeThroughBlack{ticks} }
+
+func (t fadeThroughBlack) Ticks() int { return t.ticks }
+
+func (t fadeThroughBlack) Draw(screen, from, to *ebiten.Image, progress float32) {
+	img, alpha := from, 1-progress*2
+	if progress >= 0.5 {
+		img, alpha = to, progress*2-1
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	op.ColorScale.Scale(alpha, alpha, alpha, 1)
+	screen.DrawImage(img, op)
+}
+
+type wipe struct{ ticks int }
+
+// Wipe sweeps the incoming scene across from the left edge.
+func Wipe(ticks int) Transition { return wipe{ticks} }
+
+func (t wipe) Ticks() int { return t.ticks }
+
+func (t wipe) Draw(screen, from, to *ebiten.Image, progress float32) {
+	screen.DrawImage(from, nil)
+
+	edge := int(progress * engine.ScreenWidth)
+	screen.DrawImage(to.SubImage(image.Rect(0, 0, edge, engine.ScreenHeight)).(*ebiten.Image), nil)
+}
+
+type iris struct{ ticks int }
+
+// Iris reveals the incoming scene through a circle growing from the screen centre.
+func Iris(ticks int) Transition { return iris{ticks} }
+
+func (t iris) Ticks() int { return t.ticks }
+
+func (t iris) Draw(screen, from, to *ebiten.Image, progress float32) {
+	screen.DrawImage(from, nil)
+
+	/* the circle must reach the corners, so its full radius is half the diagonal */
+	radius := progress * float32(math.Hypot(engine.ScreenWidth, engine.ScreenHeight)) / 2
+
+	irisMask.Clear()
+	vector.DrawFilledCircle(irisMask, engine.ScreenWidth/2, engine.ScreenHeight/2, radius, color.White, true)
+
+	/* keep the incoming scene only where the circle was drawn */
+	op := &ebiten.DrawImageOptions{}
+	op.Blend = ebiten.BlendSourceIn
+	irisMask.DrawImage(to, op)
+
+	screen.DrawImage(irisMask, nil)
+}
+
+type cut struct{}
+
+// Cut switches scenes instantly.
+func Cut() Transition { return cut{} }
+
+func (cut) Ticks() int { return 0 }
+
+func (cut) Draw(screen, _, to *ebiten.Image, _ float32) {
+	screen.DrawImage(to, nil)
+}
@@ -0,0 +1,96 @@
+package scene
+
+import "testing"
+
+func TestTransitionsRunForTheirTicks(t *testing.T) {
+	tests := []struct {
+		name       string
+		transition Transition
+		ticks      int
+	}{
+		{"cross fade", CrossFade(30), 30},
+		{"fade through black", FadeThroughBlack(40), 40},
+		{"wipe", Wipe(20), 20},
+		{"iris", Iris(25), 25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.transition.Ticks(); got != tt.ticks {
+				t.Fatalf("Ticks() = %d, want %d", got, tt.ticks)
+			}
+
+			from, to := &countingScene{}, &countingScene{}
+			sm := &SceneManager{}
+			sm.GoToScene(from, Cut())
+			sm.GoToScene(to, tt.transition)
+
+			last := sm.progress()
+			if last != 0 {
+				t.Fatalf("progress starts at %v, want 0", last)
+			}
+
+			for tick := 1; tick < tt.ticks; tick++ {
+				if err := sm.Update(nil); err != nil {
+					t.Fatal(err)
+				}
+				if sm.Current() != from {
+					t.Fatalf("switched scenes after %d of %d ticks", tick, tt.ticks)
+				}
+
+				p := sm.progress()
+				if p <= last || p >= 1 {
+					t.Fatalf("progress after %d ticks = %v, want between %v and 1", tick, p, last)
+				}
+				last = p
+			}
+
+			if err := sm.Update(nil); err != nil {
+				t.Fatal(err)
+			}
+			if sm.Current() != to || sm.next != nil || sm.transitionCount != 0 {
+				t.Fatalf("transition not finished after %d ticks", tt.ticks)
+			}
+
+			/* neither scene runs while the transition is on screen */
+			if from.updates != 0 || to.updates != 0 {
+				t.Errorf("updates during the transition: from %d, to %d", from.updates, to.updates)
+			}
+
+			if err := sm.Update(nil); err != nil {
+				t.Fatal(err)
+			}
+			if to.updates != 1 {
+				t.Errorf("incoming scene updated %d times after the transition, want 1", to.updates)
+			}
+		})
+	}
+}
+
+func TestCutSwitchesWithinOneStep(t *testing.T) {
+	from, to := &countingScene{}, &countingScene{}
+	sm := &SceneManager{}
+	sm.GoToScene(from, Cut())
+	sm.GoToScene(to, Cut())
+
+	if sm.Current() != to || sm.transitionCount != 0 {
+		t.Fatal("cut should switch scenes without a transition")
+	}
+
+	if err := sm.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+	if from.updates != 0 || to.updates != 1 {
+		t.Errorf("after one step: from %d, to %d updates; want 0 and 1", from.updates, to.updates)
+	}
+}
+
+func TestFirstSceneSkipsTheTransition(t *testing.T) {
+	first := &countingScene{}
+	sm := &SceneManager{}
+	sm.GoToScene(first, CrossFade(30))
+
+	if sm.Current() != first || sm.transitionCount != 0 {
+		t.Fatal("the very first scene should be shown immediately")
+	}
+}
@@ -0,0 +1,51 @@
+package settings
+
+import "fmt"
+
+// Difficulty selects how aggressive meteors and aliens are.
+type Difficulty int
+
+const (
+	Easy Difficulty = iota
+	Normal
+	Hard
+
+	difficultyCount
+)
+
+var difficultyNames = [difficultyCount]string{
+	Easy:   "Easy",
+	Normal: "Normal",
+	Hard:   "Hard",
+}
+
+func (d Difficulty) String() string {
+	if !d.Valid() {
+		return fmt.Sprintf("Difficulty(%d)", int(d))
+	}
+	return difficultyNames[d]
+}
+
+// Valid reports whether d is one of the difficulties offered.
+func (d Difficulty) Valid() bool {
+	return d >= 0 && d < difficultyCount
+}
+
+// Next steps through the difficulties in either direction, wrapping at the ends.
+func (d Difficulty) Next(step int) Difficulty {
+	return Difficulty((int(d) + step + int(difficultyCount)) % int(difficultyCount))
+}
+
+func (d Difficulty) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Difficulty) UnmarshalText(text []byte) error {
+	for i, name := range difficultyNames {
+		if name == string(text) {
+			*d = Difficulty(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("settings: unknown difficulty %q", string(text))
+}
@@ -0,0 +1,52 @@
+package settings
+
+import "fmt"
+
+// Movement selects how the ship flies.
+type Movement int
+
+const (
+	// Newtonian ships keep their momentum, bleeding it off through drag.
+	Newtonian Movement = iota
+	// Arcade is the original handling: the ship surges while thrusting and
+	// drifts along its heading when released.
+	Arcade
+
+	movementCount
+)
+
+var movementNames = [movementCount]string{
+	Newtonian: "Newtonian",
+	Arcade:    "Arcade",
+}
+
+func (m Movement) String() string {
+	if !m.Valid() {
+		return fmt.Sprintf("Movement(%d)", int(m))
+	}
+	return movementNames[m]
+}
+
+// Valid reports whether m is one of the movement profiles offered.
+func (m Movement) Valid() bool {
+	return m >= 0 && m < movementCount
+}
+
+// Next steps through the movement profiles in either direction, wrapping at the ends.
+func (m Movement) Next(step int) Movement {
+	return Movement((int(m) + step + int(movementCount)) % int(movementCount))
+}
+
+func (m Movement) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Movement) UnmarshalText(text []byte) error {
+	for i, name := range movementNames {
+		if name == string(text) {
+			*m = Movement(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("settings: unknown movement %q", string(text))
+}
@@ -0,0 +1,116 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-asteroids/internal/engine"
+	"go-asteroids/internal/sound"
+	"go-asteroids/internal/storage"
+	"io/fs"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const fileName = "settings.json"
+
+// WindowScales are the window sizes offered, as multiples of the logical screen.
+var WindowScales = []float64{0.5, 0.75, 1, 1.25, 1.5}
+
+// Settings are the player's preferences, kept beside the high score store.
+type Settings struct {
+	MasterVolume float64    `json:"masterVolume"`
+	MusicVolume  float64    `json:"musicVolume"`
+	SFXVolume    float64    `json:"sfxVolume"`
+	Muted        bool       `json:"muted"`
+	Fullscreen   bool       `json:"fullscreen"`
+	WindowScale  float64    `json:"windowScale"`
+	VSync        bool       `json:"vsync"`
+	Difficulty   Difficulty `json:"difficulty"`
+	Movement     Movement   `json:"movement"`
+	WrapLasers   bool       `json:"wrapLasers"`
+}
+
+func Default() *Settings {
+	return &Settings{
+		MasterVolume: 1,
+		MusicVolume:  1,
+		SFXVolume:    1,
+		WindowScale:  1,
+		VSync:        true,
+		Difficulty:   Normal,
+		Movement:     Newtonian,
+	}
+}
+
+// Load reads the settings file. A missing file gives the defaults; so does a
+// corrupt one or one holding values out of range, alongside an error.
+func Load() (*Settings, error) {
+	s := Default()
+
+	path, err := storage.Path(fileName)
+	if err != nil {
+		return s, err
+	}
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+
+	if err := json.Unmarshal(contents, s); err != nil {
+		return Default(), fmt.Errorf("settings: corrupt settings file %s: %w", path, err)
+	}
+	if err := s.validate(); err != nil {
+		return Default(), fmt.Errorf("settings: corrupt settings file %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+/* validate rejects values the menus never produce, which only a hand-edited file can hold */
+func (s *Settings) validate() error {
+	for _, v := range []float64{s.MasterVolume, s.MusicVolume, s.SFXVolume} {
+		if v < 0 || v > 1 {
+			return fmt.Errorf("volume %v outside 0 to 1", v)
+		}
+	}
+	if s.WindowScale <= 0 {
+		return fmt.Errorf("window scale %v is not positive", s.WindowScale)
+	}
+
+	return nil
+}
+
+func Save(s *Settings) error {
+	path, err := storage.Path(fileName)
+	if err != nil {
+		return err
+	}
+
+	contents, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return storage.WriteFile(path, contents)
+}
+
+// Apply pushes the display settings to the window.
+func (s *Settings) Apply() {
+	ebiten.SetFullscreen(s.Fullscreen)
+	ebiten.SetVsyncEnabled(s.VSync)
+	ebiten.SetWindowSize(int(engine.ScreenWidth*s.WindowScale), int(engine.ScreenHeight*s.WindowScale))
+}
+
+// ApplySound pushes the volume settings to the sound buses.
+func (s *Settings) ApplySound(m *sound.Manager) {
+	m.SetMaster(s.MasterVolume)
+	m.SetVolume(sound.Music, s.MusicVolume)
+	m.SetVolume(sound.SFX, s.SFXVolume)
+	m.SetMuted(s.Muted)
+}
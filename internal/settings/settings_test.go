@@ -0,0 +1,77 @@
+package settings
+
+import (
+	"go-asteroids/internal/storage"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func useTempStore(t *testing.T) string {
+	dir := t.TempDir()
+	storage.SetDir(dir)
+	t.Cleanup(func() { storage.SetDir("") })
+
+	return dir
+}
+
+func TestLoadWithoutStore(t *testing.T) {
+	useTempStore(t)
+
+	s, err := Load()
+	if err != nil || *s != *Default() {
+		t.Fatalf("Load() = %+v, %v; want defaults, nil", s, err)
+	}
+}
+
+func TestSaveThenLoad(t *testing.T) {
+	useTempStore(t)
+
+	want := Default()
+	want.MusicVolume = 0.4
+	want.Fullscreen = true
+	want.Difficulty = Hard
+	want.Movement = Arcade
+	want.WrapLasers = true
+	if err := Save(want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != *want {
+		t.Fatalf("Load() = %+v; want %+v", got, want)
+	}
+}
+
+func TestLoadCorrupt(t *testing.T) {
+	for _, contents := range []string{
+		`{"difficulty": "Impossible"}`,
+		`{"windowScale": 0}`,
+		`{"windowScale": -1}`,
+		`{"sfxVolume": 1.5}`,
+		`{"masterVolume": -0.1}`,
+	} {
+		dir := useTempStore(t)
+
+		if err := os.WriteFile(filepath.Join(dir, fileName), []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		s, err := Load()
+		if err == nil || *s != *Default() {
+			t.Errorf("Load(%s) = %+v, %v; want defaults and an error", contents, s, err)
+		}
+	}
+}
+
+func TestDifficultyNextWraps(t *testing.T) {
+	if got := Easy.Next(-1); got != Hard {
+		t.Errorf("Easy.Next(-1) = %v; want Hard", got)
+	}
+	if got := Hard.Next(1); got != Easy {
+		t.Errorf("Hard.Next(1) = %v; want Easy", got)
+	}
+}
@@ -0,0 +1,86 @@
+package sound
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"sync"
+)
+
+/* 16-bit little-endian stereo, the format ebiten players take */
+const (
+	bytesPerSample = 2
+	bytesPerFrame  = 2 * bytesPerSample
+)
+
+// Position places a voice in the mix: Pan runs from -1 (hard left) to 1
+// (hard right) and Gain scales its volume.
+type Position struct {
+	Pan  float64
+	Gain float64
+}
+
+// Centre plays a sound as recorded.
+var Centre = Position{Gain: 1}
+
+/* balance turns a pan into per-channel gains, leaving a centred sound untouched */
+func balance(pan float64) (left, right float64) {
+	pan = min(max(pan, -1), 1)
+	return min(1, 1-pan), min(1, 1+pan)
+}
+
+/* panner feeds a player its PCM with the left and right channels scaled; the audio goroutine reads while the game sets the pan */
+type panner struct {
+	src *bytes.Reader
+
+	mu          sync.Mutex
+	left, right float64
+}
+
+func newPanner(data []byte) *panner {
+	return &panner{src: bytes.NewReader(data), left: 1, right: 1}
+}
+
+func (p *panner) setPan(pan float64) {
+	left, right := balance(pan)
+
+	p.mu.Lock()
+	p.left, p.right = left, right
+	p.mu.Unlock()
+}
+
+func (p *panner) Read(b []byte) (int, error) {
+	/* read whole frames so each one is scaled in a single pass */
+	if len(b) >= bytesPerFrame {
+		b = b[:len(b)-len(b)%bytesPerFrame]
+	}
+
+	n, err := p.src.Read(b)
+
+	p.mu.Lock()
+	left, right := p.left, p.right
+	p.mu.Unlock()
+
+	if left == 1 && right == 1 {
+		return n, err
+	}
+
+	for i := 0; i+bytesPerFrame <= n; i += bytesPerFrame {
+		scaleSample(b[i:], left)
+		scaleSample(b[i+bytesPerSample:], right)
+	}
+
+	return n, err
+}
+
+func (p *panner) Seek(offset int64, whence int) (int64, error) {
+	return p.src.Seek(offset, whence)
+}
+
+func scaleSample(b []byte, gain float64) {
+	s := float64(int16(binary.LittleEndian.Uint16(b)))
+	binary.LittleEndian.PutUint16(b, uint16(int16(math.Round(s*gain))))
+}
+
+var _ io.ReadSeeker = (*panner)(nil)
@@ -0,0 +1,311 @@
+// Package sound owns the game's single audio context and plays named sounds
+// through volume-controlled buses. A nil *Manager is silent, so headless
+// simulations can call it freely.
+package sound
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+)
+
+const (
+	SampleRate = 48000
+
+	// DefaultMaxVoices caps how many sounds play at once across every bus.
+	DefaultMaxVoices = 16
+)
+
+// Bus groups sounds that share a volume setting.
+type Bus int
+
+const (
+	SFX Bus = iota
+	Music
+
+	busCount
+)
+
+// Manager plays registered sounds, reusing a small pool of players per sound.
+type Manager struct {
+	context   *audio.Context
+	sounds    map[string]*sound
+	master    float64
+	buses     [busCount]float64
+	muted     bool
+	maxVoices int
+	paused    []*voice
+}
+
+type sound struct {
+	data   []byte
+	bus    Bus
+	volume float64
+	limit  int
+	voices []*voice
+}
+
+/* voice is one player of a sound, mixed through its own panner */
+type voice struct {
+	player   *audio.Player
+	panner   *panner
+	position Position
+}
+
+func NewManager() *Manager {
+	/* ebiten allows one context per process, so pick up any that already exists */
+	context := audio.CurrentContext()
+	if context == nil {
+		context = audio.NewContext(SampleRate)
+	}
+
+	return &Manager{
+		context:   context,
+		sounds:    make(map[string]*sound),
+		master:    1,
+		buses:     [busCount]float64{SFX: 1, Music: 1},
+		maxVoices: DefaultMaxVoices,
+	}
+}
+
+// Register decodes src once and files it under name. volume is the sound's
+// level before the bus and master volumes; voices is how many copies of it
+// may overlap.
+func (m *Manager) Register(name string, src io.Reader, bus Bus, volume float64, voices int) error {
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return fmt.Errorf("sound: reading %s: %w", name, err)
+	}
+
+	m.sounds[name] = &sound{
+		data:   data,
+		bus:    bus,
+		volume: volume,
+		limit:  max(voices, 1),
+	}
+
+	return nil
+}
+
+// Play starts name centred on a free voice. If every voice of it is busy, or
+// the manager is at its voice cap, the call is dropped.
+func (m *Manager) Play(name string) {
+	m.PlayAt(name, Centre)
+}
+
+// PlayAt is Play with the voice panned and attenuated by pos.
+func (m *Manager) PlayAt(name string, pos Position) {
+	if m == nil {
+		return
+	}
+
+	s, ok := m.sounds[name]
+	if !ok || m.activeVoices() >= m.maxVoices {
+		return
+	}
+
+	for _, v := range s.voices {
+		if !v.player.IsPlaying() {
+			m.start(s, v, pos)
+			return
+		}
+	}
+
+	if len(s.voices) < s.limit {
+		v := &voice{panner: newPanner(s.data)}
+		player, err := m.context.NewPlayer(v.panner)
+		if err != nil {
+			return
+		}
+		v.player = player
+
+		s.voices = append(s.voices, v)
+		m.start(s, v, pos)
+	}
+}
+
+// Move repositions every voice of name, for sounds that follow a moving emitter.
+func (m *Manager) Move(name string, pos Position) {
+	if m == nil {
+		return
+	}
+
+	if s, ok := m.sounds[name]; ok {
+		for _, v := range s.voices {
+			m.place(s, v, pos)
+		}
+	}
+}
+
+// Restart plays name from the beginning, cutting off its first voice if it
+// is still sounding.
+func (m *Manager) Restart(name string) {
+	if m == nil {
+		return
+	}
+
+	s, ok := m.sounds[name]
+	if !ok {
+		return
+	}
+
+	if len(s.voices) == 0 || !s.voices[0].player.IsPlaying() {
+		m.Play(name)
+		return
+	}
+
+	m.start(s, s.voices[0], Centre)
+}
+
+// Stop pauses every voice of name.
+func (m *Manager) Stop(name string) {
+	if m == nil {
+		return
+	}
+
+	if s, ok := m.sounds[name]; ok {
+		for _, v := range s.voices {
+			v.player.Pause()
+		}
+	}
+}
+
+func (m *Manager) IsPlaying(name string) bool {
+	if m == nil {
+		return false
+	}
+
+	if s, ok := m.sounds[name]; ok {
+		for _, v := range s.voices {
+			if v.player.IsPlaying() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// PauseAll holds every playing voice until ResumeAll.
+func (m *Manager) PauseAll() {
+	if m == nil {
+		return
+	}
+
+	m.paused = nil
+	m.eachVoice(func(_ *sound, v *voice) {
+		if v.player.IsPlaying() {
+			v.player.Pause()
+			m.paused = append(m.paused, v)
+		}
+	})
+}
+
+func (m *Manager) ResumeAll() {
+	if m == nil {
+		return
+	}
+
+	for _, v := range m.paused {
+		v.player.Play()
+	}
+	m.paused = nil
+}
+
+// StopAll silences everything, including voices held by PauseAll.
+func (m *Manager) StopAll() {
+	if m == nil {
+		return
+	}
+
+	m.paused = nil
+	m.eachVoice(func(_ *sound, v *voice) {
+		v.player.Pause()
+	})
+}
+
+func (m *Manager) SetMaster(volume float64) {
+	if m == nil {
+		return
+	}
+
+	m.master = volume
+	m.refresh()
+}
+
+func (m *Manager) SetVolume(bus Bus, volume float64) {
+	if m == nil {
+		return
+	}
+
+	m.buses[bus] = volume
+	m.refresh()
+}
+
+func (m *Manager) SetMuted(muted bool) {
+	if m == nil {
+		return
+	}
+
+	m.muted = muted
+	m.refresh()
+}
+
+func (m *Manager) Muted() bool {
+	return m != nil && m.muted
+}
+
+func (m *Manager) SetMaxVoices(n int) {
+	if m == nil {
+		return
+	}
+
+	m.maxVoices = n
+}
+
+func (m *Manager) start(s *sound, v *voice, pos Position) {
+	m.place(s, v, pos)
+	_ = v.player.Rewind()
+	v.player.Play()
+}
+
+func (m *Manager) place(s *sound, v *voice, pos Position) {
+	v.position = pos
+	v.panner.setPan(pos.Pan)
+	v.player.SetVolume(m.gain(s) * pos.Gain)
+}
+
+/* gain is the player volume for s: its own level scaled by its bus and the master volume */
+func (m *Manager) gain(s *sound) float64 {
+	if m.muted {
+		return 0
+	}
+
+	return s.volume * m.buses[s.bus] * m.master
+}
+
+func (m *Manager) refresh() {
+	m.eachVoice(func(s *sound, v *voice) {
+		v.player.SetVolume(m.gain(s) * v.position.Gain)
+	})
+}
+
+func (m *Manager) activeVoices() int {
+	n := 0
+	m.eachVoice(func(_ *sound, v *voice) {
+		if v.player.IsPlaying() {
+			n++
+		}
+	})
+
+	return n
+}
+
+func (m *Manager) eachVoice(f func(s *sound, v *voice)) {
+	for _, s := range m.sounds {
+		for _, v := range s.voices {
+			f(s, v)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package sound
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestNilManagerIsSilent(t *testing.T) {
+	var m *Manager
+
+	m.Play("thrust")
+	m.Restart("thrust")
+	m.Stop("thrust")
+	m.PauseAll()
+	m.ResumeAll()
+	m.StopAll()
+	m.SetMaster(0.5)
+	m.SetVolume(Music, 0.5)
+	m.SetMuted(true)
+
+	if m.IsPlaying("thrust") || m.Muted() {
+		t.Fatal("nil manager reports sound")
+	}
+}
+
+func TestGain(t *testing.T) {
+	m := &Manager{
+		sounds:    make(map[string]*sound),
+		master:    1,
+		buses:     [busCount]float64{SFX: 1, Music: 1},
+		maxVoices: DefaultMaxVoices,
+	}
+	if err := m.Register("beat", strings.NewReader("pcm"), Music, 0.5, 1); err != nil {
+		t.Fatal(err)
+	}
+	beat := m.sounds["beat"]
+
+	m.SetMaster(0.5)
+	m.SetVolume(Music, 0.8)
+	m.SetVolume(SFX, 0)
+	if got := m.gain(beat); got != 0.2 {
+		t.Errorf("gain = %v; want 0.2", got)
+	}
+
+	m.SetMuted(true)
+	if got := m.gain(beat); got != 0 {
+		t.Errorf("muted gain = %v; want 0", got)
+	}
+}
+
+func TestBalance(t *testing.T) {
+	tests := []struct {
+		pan         float64
+		left, right float64
+	}{
+		{0, 1, 1},
+		{-1, 1, 0},
+		{1, 0, 1},
+		{0.5, 0.5, 1},
+		{-3, 1, 0},
+	}
+
+	for _, tt := range tests {
+		if left, right := balance(tt.pan); left != tt.left || right != tt.right {
+			t.Errorf("balance(%v) = %v, %v; want %v, %v", tt.pan, left, right, tt.left, tt.right)
+		}
+	}
+}
+
+func TestPannerScalesChannels(t *testing.T) {
+	left, right := int16(1000), int16(-1000)
+	frame := make([]byte, bytesPerFrame)
+	binary.LittleEndian.PutUint16(frame, uint16(left))
+	binary.LittleEndian.PutUint16(frame[bytesPerSample:], uint16(right))
+
+	p := newPanner(frame)
+	p.setPan(0.5)
+
+	got := make([]byte, bytesPerFrame)
+	if _, err := io.ReadFull(p, got); err != nil {
+		t.Fatal(err)
+	}
+
+	left = int16(binary.LittleEndian.Uint16(got))
+	right = int16(binary.LittleEndian.Uint16(got[bytesPerSample:]))
+	if left != 500 || right != -1000 {
+		t.Errorf("panned frame = %d, %d; want 500, -1000", left, right)
+	}
+}
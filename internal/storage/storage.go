@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const appDir = "Go Asteroids"
+
+var override string
+
+// Dir is the per-user directory game data lives in: "Go Asteroids" inside
+// os.UserConfigDir, which honours XDG_CONFIG_HOME on Linux.
+func Dir() (string, error) {
+	if override != "" {
+		return override, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, appDir), nil
+}
+
+// SetDir redirects all storage to dir; an empty dir restores the default. Meant for tests.
+func SetDir(dir string) {
+	override = dir
+}
+
+// Path joins name onto Dir.
+func Path(name ...string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(append([]string{dir}, name...)...), nil
+}
+
+// WriteFile writes data to a temporary file beside path and renames it into
+// place, so a crash mid-write never leaves a truncated file behind.
+func WriteFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	/* clean up the temp file on any failure; harmless after a successful rename */
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
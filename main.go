@@ -1,18 +1,66 @@
 package main
 
 import (
-	"go-asteroids/internal/engine"
+	"flag"
+	"fmt"
 	"go-asteroids/internal/game"
+	"go-asteroids/internal/replay"
+	"go-asteroids/internal/scene"
+	"go-asteroids/internal/settings"
+	"log"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 func main() {
-	ebiten.SetWindowTitle("Go Asteroids")
-	ebiten.SetWindowSize(engine.ScreenWidth, engine.ScreenHeight)
+	seed := flag.Int64("seed", 0, "seed for gameplay randomness (0 picks one per run)")
+	replayPath := flag.String("replay", "", "play back a recorded .replay file")
+	headless := flag.Bool("headless", false, "run the simulation without a window and print the result")
+	ticks := flag.Int("ticks", 5000, "number of ticks to simulate with -headless")
+	flag.Parse()
+
+	g := &game.Game{Seed: *seed}
+	if *replayPath != "" {
+		r, err := replay.Load(*replayPath)
+		if err != nil {
+			log.Fatalln("Error loading replay", err)
+		}
+		g.Replay = r
+	}
+
+	if *headless {
+		runHeadless(g, *ticks)
+		return
+	}
 
-	err := ebiten.RunGame(&game.Game{})
+	s, err := settings.Load()
 	if err != nil {
+		log.Println("Error loading settings", err)
+	}
+	g.Settings = s
+
+	ebiten.SetWindowTitle("Go Asteroids")
+	s.Apply()
+
+	if err := ebiten.RunGame(g); err != nil {
 		panic(err)
 	}
 }
+
+/* runHeadless simulates an idle run, or plays back a replay to the end, and prints where it finished */
+func runHeadless(g *game.Game, ticks int) {
+	sim := scene.NewSimulation(g.Seed, nil)
+	if g.Replay != nil {
+		sim = scene.NewReplaySimulation(g.Replay)
+		ticks = math.MaxInt
+	}
+
+	res, err := sim.Run(ticks)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	fmt.Printf("seed %d: %d ticks, level %d, score %d, lives %d, game over %t\n",
+		res.Seed, res.Ticks, res.Level, res.Score, res.LivesRemaining, res.GameOver)
+}